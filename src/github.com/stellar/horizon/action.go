@@ -6,6 +6,7 @@ import (
 	"strings"
 
 	"github.com/stellar/horizon/actions"
+	"github.com/stellar/horizon/bus"
 	"github.com/stellar/horizon/db2/core"
 	"github.com/stellar/horizon/db2/history"
 	"github.com/stellar/horizon/httpx"
@@ -145,3 +146,14 @@ func (action *Action) EnsureHistoryFreshness() {
 func (action *Action) BaseURL() *url.URL {
 	return httpx.BaseURL(action.Ctx)
 }
+
+// accountTopics returns the bus topics watched by a stream scoped to the
+// account identified by `address`, or by an unscoped stream when `address` is
+// empty.
+func accountTopics(address string) []string {
+	if address == "" {
+		return []string{bus.TopicLedgers}
+	}
+
+	return []string{bus.AccountTopic(address)}
+}
@@ -5,9 +5,11 @@ import (
 
 	gctx "github.com/goji/context"
 
+	"github.com/stellar/horizon/bus"
 	"github.com/stellar/horizon/render"
 	"github.com/stellar/horizon/render/problem"
 	"github.com/stellar/horizon/render/sse"
+	"github.com/stellar/horizon/render/ws"
 	"github.com/zenazn/goji/web"
 	"golang.org/x/net/context"
 )
@@ -62,10 +64,32 @@ func (base *Base) Execute(action interface{}) {
 			goto NotAcceptable
 		}
 
-		stream := sse.NewStream(base.Ctx, base.W, base.R)
+		var stream sse.Stream
+		if ws.IsUpgrade(base.R) {
+			wss := ws.NewStream(base.Ctx, base.W, base.R)
+			defer wss.Close()
+			base.Ctx = wss.Context()
+			stream = wss
+		} else {
+			stream = sse.NewStream(base.Ctx, base.W, base.R)
+		}
+
+		var (
+			sub    *bus.Subscription
+			events []bus.Event
+		)
+
+		// Subscribe before the action first runs, so that no ledger ingested
+		// between the action's query and the subscription is missed.
+		if watcher, ok := action.(Watcher); ok && bus.Enabled() {
+			sub = bus.Subscribe(watcher.Topics()...)
+			defer sub.Close()
+		}
 
 		for {
-			action.SSE(stream)
+			if !base.sendEvents(action, stream, events) {
+				action.SSE(stream)
+			}
 
 			if base.Err != nil {
 				// in the case that we haven't yet sent an event, is also means we
@@ -83,11 +107,20 @@ func (base *Base) Execute(action interface{}) {
 				return
 			}
 
-			select {
-			case <-base.Ctx.Done():
+			if sub == nil {
+				select {
+				case <-base.Ctx.Done():
+					return
+				case <-sse.Pumped():
+					//no-op, continue onto the next iteration
+				}
+				continue
+			}
+
+			var ok bool
+			events, ok = base.wait(sub)
+			if !ok {
 				return
-			case <-sse.Pumped():
-				//no-op, continue onto the next iteration
 			}
 		}
 	case render.MimeRaw:
@@ -113,6 +146,46 @@ NotAcceptable:
 	return
 }
 
+// wait blocks until the stream should be updated, returning the events
+// published to `sub` since it last returned, or nil if the action needs to be
+// re-run instead.  The pump is watched as well as the subscription, so that a
+// stream falls back to polling as soon as this process stops publishing, such
+// as when it loses the ingestion election.  It returns false if the request is
+// done.
+func (base *Base) wait(sub *bus.Subscription) ([]bus.Event, bool) {
+	for {
+		select {
+		case <-base.Ctx.Done():
+			return nil, false
+		case <-sse.Pumped():
+			if !bus.Enabled() {
+				return nil, true
+			}
+		case <-sub.Notify():
+			events, complete := sub.Drain()
+			if !complete {
+				events = nil
+			}
+			return events, true
+		}
+	}
+}
+
+// sendEvents sends the records for `events` using the action's EventSSE
+// implementation, returning false if the action needs to be re-run instead.
+func (base *Base) sendEvents(action SSE, stream sse.Stream, events []bus.Event) bool {
+	if len(events) == 0 {
+		return false
+	}
+
+	es, ok := action.(EventSSE)
+	if !ok {
+		return false
+	}
+
+	return es.SSEEvents(stream, events)
+}
+
 // Do executes the provided func iff there is no current error for the action.
 // Provides a nicer way to invoke a set of steps that each may set `action.Err`
 // during execution
@@ -0,0 +1,72 @@
+package actions
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stellar/horizon/bus"
+	"github.com/stellar/horizon/db2/history"
+	"github.com/stellar/horizon/render/sse"
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/net/context"
+)
+
+func TestBaseWait(t *testing.T) {
+	var (
+		lock       sync.Mutex
+		publishing = true
+	)
+	setPublishing := func(p bool) {
+		lock.Lock()
+		publishing = p
+		lock.Unlock()
+	}
+
+	bus.EnableWhen(func() bool {
+		lock.Lock()
+		defer lock.Unlock()
+		return publishing
+	})
+	defer bus.EnableWhen(func() bool { return false })
+
+	sub := bus.Subscribe(bus.TopicLedgers)
+	defer sub.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	base := &Base{Ctx: ctx}
+
+	// events published to the subscription are returned
+	bus.Publish(bus.Event{Ledger: history.Ledger{Sequence: 2}})
+	events, ok := base.wait(sub)
+	assert.True(t, ok)
+	assert.Len(t, events, 1)
+
+	done := make(chan []bus.Event)
+	go func() {
+		events, _ := base.wait(sub)
+		done <- events
+	}()
+
+	// the pump does not wake a stream while the bus is published to
+	sse.Tick()
+	select {
+	case <-done:
+		t.Fatal("woken by the pump while publishing")
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	// but does once this process stops publishing
+	setPublishing(false)
+	sse.Tick()
+	select {
+	case events := <-done:
+		assert.Nil(t, events)
+	case <-time.After(time.Second):
+		t.Fatal("not woken by the pump after publishing stopped")
+	}
+
+	cancel()
+	_, ok = base.wait(sub)
+	assert.False(t, ok)
+}
@@ -4,6 +4,7 @@ import (
 	"mime"
 	"net/url"
 	"strconv"
+	"strings"
 
 	"github.com/stellar/go/amount"
 	"github.com/stellar/go/strkey"
@@ -75,6 +76,46 @@ func (base *Base) GetString(name string) string {
 	return base.R.URL.Query().Get(name)
 }
 
+// GetStrings retrieves every value provided for `name` in either the form or
+// the query string, in the order they were provided.
+func (base *Base) GetStrings(name string) []string {
+	if base.Err != nil {
+		return nil
+	}
+
+	// FormValue populates the form as GetString would, ignoring parse errors.
+	base.R.FormValue(name)
+	return base.R.Form[name]
+}
+
+// GetAssets retrieves a list of assets from the action parameter of the given
+// name.  The list may be comma separated, the parameter may be repeated, or
+// both.  Each asset is written as either `native` or `<code>:<issuer>`.
+// Populates err if any asset is invalid.
+func (base *Base) GetAssets(name string) (result []xdr.Asset) {
+	if base.Err != nil {
+		return nil
+	}
+
+	for _, value := range base.GetStrings(name) {
+		for _, part := range strings.Split(value, ",") {
+			if part == "" {
+				continue
+			}
+
+			asset, err := assets.FromString(part)
+			if err != nil {
+				base.SetInvalidField(name, err)
+				return nil
+			}
+
+			result = append(result, asset)
+		}
+	}
+
+	return
+}
+
 // GetInt64 retrieves an int64 from the action parameter of the given name.
 // Populates err if the value is not a valid int64
 func (base *Base) GetInt64(name string) int64 {
@@ -121,6 +162,28 @@ func (base *Base) GetInt32(name string) int32 {
 	return int32(asI64)
 }
 
+// GetBool retrieves a bool from the action parameter of the given name.
+// Populates err if the value is not a valid bool.  A blank value is
+// interpreted as false.
+func (base *Base) GetBool(name string) bool {
+	if base.Err != nil {
+		return false
+	}
+
+	asStr := base.GetString(name)
+	if asStr == "" {
+		return false
+	}
+
+	asBool, err := strconv.ParseBool(asStr)
+	if err != nil {
+		base.SetInvalidField(name, err)
+		return false
+	}
+
+	return asBool
+}
+
 // GetLimit retrieves a uint64 limit from the action parameter of the given
 // name. Populates err if the value is not a valid limit.  Uses the provided
 // default value if the limit parameter is a blank string.
@@ -226,7 +289,7 @@ func (base *Base) GetAccountID(name string) (result xdr.AccountId) {
 // conventions
 func (base *Base) GetAmount(name string) (result xdr.Int64) {
 	var err error
-	result, err = amount.Parse(base.GetString("destination_amount"))
+	result, err = amount.Parse(base.GetString(name))
 
 	if err != nil {
 		base.SetInvalidField(name, err)
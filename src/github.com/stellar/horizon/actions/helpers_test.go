@@ -28,6 +28,20 @@ func TestGetAccountID(t *testing.T) {
 	)
 }
 
+func TestGetAmount(t *testing.T) {
+	tt := test.Start(t)
+	defer tt.Finish()
+
+	action := makeAction("/?source_amount=10.5&destination_amount=1", nil)
+	tt.Assert.Equal(xdr.Int64(105000000), action.GetAmount("source_amount"))
+	tt.Assert.NoError(action.Err)
+
+	// invalid
+	action = makeAction("/?source_amount=bogus", nil)
+	action.GetAmount("source_amount")
+	tt.Assert.Error(action.Err)
+}
+
 func TestGetAsset(t *testing.T) {
 	tt := test.Start(t)
 	defer tt.Finish()
@@ -53,6 +67,30 @@ func TestGetAsset(t *testing.T) {
 	tt.Assert.Error(action.Err)
 }
 
+func TestGetAssets(t *testing.T) {
+	tt := test.Start(t)
+	defer tt.Finish()
+
+	issuer := "GBRPYHIL2CI3FNQ4BXLFMNDLFJUNPU2HY3ZMFSHONUCEOASW7QC7OX2H"
+
+	action := makeAction("/?a=native,USD:"+issuer+"&a=EUR:"+issuer, nil)
+	result := action.GetAssets("a")
+	if tt.Assert.NoError(action.Err) && tt.Assert.Len(result, 3) {
+		tt.Assert.Equal("native", result[0].String())
+		tt.Assert.Equal("credit_alphanum4/USD/"+issuer, result[1].String())
+		tt.Assert.Equal("credit_alphanum4/EUR/"+issuer, result[2].String())
+	}
+
+	action = makeAction("/", nil)
+	tt.Assert.Empty(action.GetAssets("a"))
+	tt.Assert.NoError(action.Err)
+
+	// invalid
+	action = makeAction("/?a=native,USD", nil)
+	action.GetAssets("a")
+	tt.Assert.Error(action.Err)
+}
+
 func TestGetAssetType(t *testing.T) {
 	tt := test.Start(t)
 	defer tt.Finish()
@@ -73,6 +111,30 @@ func TestGetAssetType(t *testing.T) {
 		tt.Assert.Equal(xdr.AssetTypeAssetTypeCreditAlphanum12, ts)
 	}
 }
+
+func TestGetBool(t *testing.T) {
+	tt := test.Start(t)
+	defer tt.Finish()
+
+	action := makeAction("/?include_failed=true", nil)
+	tt.Assert.True(action.GetBool("include_failed"))
+	tt.Assert.NoError(action.Err)
+
+	action = makeAction("/?include_failed=false", nil)
+	tt.Assert.False(action.GetBool("include_failed"))
+	tt.Assert.NoError(action.Err)
+
+	// blank is false
+	action = makeAction("/", nil)
+	tt.Assert.False(action.GetBool("include_failed"))
+	tt.Assert.NoError(action.Err)
+
+	// invalid
+	action = makeAction("/?include_failed=maybe", nil)
+	action.GetBool("include_failed")
+	tt.Assert.Error(action.Err)
+}
+
 func TestGetCursor(t *testing.T) {
 	tt := test.Start(t)
 	defer tt.Finish()
@@ -249,6 +311,15 @@ func TestGetString(t *testing.T) {
 	tt.Assert.Equal("goodbye", action.GetString("cursor"))
 }
 
+func TestGetStrings(t *testing.T) {
+	tt := test.Start(t)
+	defer tt.Finish()
+
+	action := makeAction("/?s=a&s=b&other=c", nil)
+	tt.Assert.Equal([]string{"a", "b"}, action.GetStrings("s"))
+	tt.Assert.Empty(action.GetStrings("missing"))
+}
+
 func TestPath(t *testing.T) {
 	tt := test.Start(t)
 	defer tt.Finish()
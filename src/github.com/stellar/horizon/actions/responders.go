@@ -1,6 +1,9 @@
 package actions
 
-import "github.com/stellar/horizon/render/sse"
+import (
+	"github.com/stellar/horizon/bus"
+	"github.com/stellar/horizon/render/sse"
+)
 
 // JSON implementors can respond to a request whose response type was negotiated
 // to be MimeHal or MimeJSON.
@@ -19,3 +22,19 @@ type Raw interface {
 type SSE interface {
 	SSE(sse.Stream)
 }
+
+// Watcher implementors are SSE actions that can name the bus topics affecting
+// their output.  When events are being published to the bus, such actions are
+// only re-run after an ingested ledger touches one of their topics, rather
+// than on every tick.  Topics is called before the action first runs, and so
+// must derive the topics from the request alone.
+type Watcher interface {
+	Topics() []string
+}
+
+// EventSSE implementors are Watchers that can sometimes send the records for
+// newly ingested ledgers straight from the published events.  SSEEvents
+// returns false if the action must instead be re-run to query the database.
+type EventSSE interface {
+	SSEEvents(sse.Stream, []bus.Event) bool
+}
@@ -49,6 +49,11 @@ func (action *AccountShowAction) SSE(stream sse.Stream) {
 	)
 }
 
+// Topics is a method for actions.Watcher
+func (action *AccountShowAction) Topics() []string {
+	return accountTopics(action.GetString("id"))
+}
+
 func (action *AccountShowAction) loadParams() {
 	action.Address = action.GetString("id")
 }
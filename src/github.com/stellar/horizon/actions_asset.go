@@ -0,0 +1,70 @@
+package horizon
+
+import (
+	"github.com/stellar/horizon/db2"
+	"github.com/stellar/horizon/db2/history"
+	"github.com/stellar/horizon/render/hal"
+	"github.com/stellar/horizon/resource"
+)
+
+// This file contains the actions:
+//
+// AssetsAction: pages of assets known to the network
+
+// AssetsAction renders a page of asset stat resources, identified by a normal
+// page query and optionally filtered by asset code and issuer.
+type AssetsAction struct {
+	Action
+	AssetCode    string
+	AssetIssuer  string
+	PagingParams db2.PageQuery
+	Records      []history.AssetStat
+	Page         hal.Page
+}
+
+// JSON is a method for actions.JSON
+func (action *AssetsAction) JSON() {
+	action.Do(
+		action.loadParams,
+		action.loadRecords,
+		action.loadPage,
+		func() { hal.Render(action.W, action.Page) },
+	)
+}
+
+func (action *AssetsAction) loadParams() {
+	action.AssetCode = action.GetString("asset_code")
+	if action.GetString("asset_issuer") != "" {
+		action.AssetIssuer = action.GetAddress("asset_issuer")
+	}
+	action.PagingParams = action.GetPageQuery()
+}
+
+func (action *AssetsAction) loadRecords() {
+	stats := action.HistoryQ().AssetStats()
+
+	if action.AssetCode != "" {
+		stats = stats.ForCode(action.AssetCode)
+	}
+
+	if action.AssetIssuer != "" {
+		stats = stats.ForIssuer(action.AssetIssuer)
+	}
+
+	action.Err = stats.Page(action.PagingParams).Select(&action.Records)
+}
+
+func (action *AssetsAction) loadPage() {
+	for _, record := range action.Records {
+		var res resource.AssetStat
+		res.Populate(action.Ctx, record)
+		action.Page.Add(res)
+	}
+
+	action.Page.BaseURL = action.BaseURL()
+	action.Page.BasePath = action.Path()
+	action.Page.Limit = action.PagingParams.Limit
+	action.Page.Cursor = action.PagingParams.Cursor
+	action.Page.Order = action.PagingParams.Order
+	action.Page.PopulateLinks()
+}
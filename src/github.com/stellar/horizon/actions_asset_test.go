@@ -0,0 +1,65 @@
+package horizon
+
+import (
+	"testing"
+
+	"github.com/stellar/horizon/resource"
+)
+
+func TestAssetsActions(t *testing.T) {
+	ht := StartHTTPTest(t, "trades")
+	defer ht.Finish()
+
+	_, err := ht.HorizonSession().ExecRaw(`
+		INSERT INTO asset_stats
+			(asset_type, asset_code, asset_issuer, amount, num_accounts, flags)
+		VALUES
+			('credit_alphanum4', 'EUR', 'GCQPYGH4K57XBDENKKX55KDTWOTK5WDWRQOH2LHEDX3EKVIQRLMESGBG', 5000000000, 2, 0),
+			('credit_alphanum4', 'USD', 'GC23QF2HUE52AMXUFUH3AYJAXXGXXV2VHXYYR6EYXETPKDXZSAW67XO4', 5000000000, 2, 3)
+	`)
+	ht.Require.NoError(err)
+
+	w := ht.Get("/assets")
+	if ht.Assert.Equal(200, w.Code) {
+		ht.Assert.PageOf(2, w.Body)
+	}
+
+	w = ht.Get("/assets?asset_code=USD")
+	if ht.Assert.Equal(200, w.Code) {
+		ht.Assert.PageOf(1, w.Body)
+
+		records := []resource.AssetStat{}
+		ht.UnmarshalPage(w.Body, &records)
+		ht.Assert.Equal("GC23QF2HUE52AMXUFUH3AYJAXXGXXV2VHXYYR6EYXETPKDXZSAW67XO4", records[0].AssetIssuer)
+		ht.Assert.Equal("500.0000000", records[0].Amount)
+		ht.Assert.Equal(int32(2), records[0].NumAccounts)
+		ht.Assert.True(records[0].Flags.AuthRequired)
+		ht.Assert.True(records[0].Flags.AuthRevocable)
+	}
+
+	w = ht.Get("/assets?asset_issuer=GCQPYGH4K57XBDENKKX55KDTWOTK5WDWRQOH2LHEDX3EKVIQRLMESGBG")
+	if ht.Assert.Equal(200, w.Code) {
+		ht.Assert.PageOf(1, w.Body)
+	}
+
+	w = ht.Get("/assets?asset_code=USD&asset_issuer=GCQPYGH4K57XBDENKKX55KDTWOTK5WDWRQOH2LHEDX3EKVIQRLMESGBG")
+	if ht.Assert.Equal(200, w.Code) {
+		ht.Assert.PageOf(0, w.Body)
+	}
+
+	// paging
+	w = ht.Get("/assets?limit=1")
+	if ht.Assert.Equal(200, w.Code) {
+		records := []resource.AssetStat{}
+		ht.UnmarshalPage(w.Body, &records)
+		ht.Assert.Equal("EUR", records[0].AssetCode)
+
+		w = ht.Get("/assets?limit=1&cursor=" + records[0].PagingToken())
+		ht.UnmarshalPage(w.Body, &records)
+		ht.Assert.Equal("USD", records[0].AssetCode)
+	}
+
+	// invalid issuer
+	w = ht.Get("/assets?asset_issuer=nope")
+	ht.Assert.Equal(400, w.Code)
+}
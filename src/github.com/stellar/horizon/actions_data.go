@@ -56,6 +56,11 @@ func (action *DataShowAction) SSE(stream sse.Stream) {
 	)
 }
 
+// Topics is a method for actions.Watcher
+func (action *DataShowAction) Topics() []string {
+	return accountTopics(action.GetString("account_id"))
+}
+
 func (action *DataShowAction) loadParams() {
 	action.Address = action.GetString("account_id")
 	action.Key = action.GetString("key")
@@ -9,6 +9,7 @@ import (
 	"github.com/stellar/horizon/render/hal"
 	"github.com/stellar/horizon/render/sse"
 	"github.com/stellar/horizon/resource"
+	"github.com/stellar/horizon/resource/effects"
 )
 
 // This file contains the actions:
@@ -17,13 +18,14 @@ import (
 
 // EffectIndexAction renders a page of effect resources, identified by
 // a normal page query and optionally filtered by an account, ledger,
-// transaction, or operation.
+// transaction, or operation, and by type.
 type EffectIndexAction struct {
 	Action
 	AccountFilter     string
 	LedgerFilter      int32
 	TransactionFilter string
 	OperationFilter   int64
+	TypeFilter        *history.EffectType
 
 	PagingParams db2.PageQuery
 	Records      []history.Effect
@@ -76,6 +78,11 @@ func (action *EffectIndexAction) SSE(stream sse.Stream) {
 	)
 }
 
+// Topics is a method for actions.Watcher
+func (action *EffectIndexAction) Topics() []string {
+	return accountTopics(action.GetString("account_id"))
+}
+
 func (action *EffectIndexAction) loadParams() {
 	action.ValidateCursor()
 	action.PagingParams = action.GetPageQuery()
@@ -83,6 +90,26 @@ func (action *EffectIndexAction) loadParams() {
 	action.LedgerFilter = action.GetInt32("ledger_id")
 	action.TransactionFilter = action.GetString("tx_id")
 	action.OperationFilter = action.GetInt64("op_id")
+	action.loadTypeFilter()
+}
+
+// loadTypeFilter resolves the name of the effect type given by the `type`
+// parameter, if any.
+func (action *EffectIndexAction) loadTypeFilter() {
+	name := action.GetString("type")
+	if action.Err != nil || name == "" {
+		return
+	}
+
+	for typ, n := range effects.TypeNames {
+		if n == name {
+			typ := typ
+			action.TypeFilter = &typ
+			return
+		}
+	}
+
+	action.SetInvalidField("type", errors.New("unknown effect type"))
 }
 
 // loadRecords populates action.Records
@@ -100,6 +127,10 @@ func (action *EffectIndexAction) loadRecords() {
 		effects.ForTransaction(action.TransactionFilter)
 	}
 
+	if action.TypeFilter != nil {
+		effects.OfType(*action.TypeFilter)
+	}
+
 	action.Err = effects.Page(action.PagingParams).Select(&action.Records)
 }
 
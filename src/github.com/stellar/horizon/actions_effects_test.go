@@ -63,6 +63,20 @@ func TestEffectActions_Index(t *testing.T) {
 		ht.Assert.PageOf(3, w.Body)
 	}
 
+	// filtered by type
+	w = ht.Get("/effects?type=signer_created")
+	if ht.Assert.Equal(200, w.Code) {
+		ht.Assert.PageOf(3, w.Body)
+	}
+
+	w = ht.Get("/accounts/GBRPYHIL2CI3FNQ4BXLFMNDLFJUNPU2HY3ZMFSHONUCEOASW7QC7OX2H/effects?type=account_created")
+	if ht.Assert.Equal(200, w.Code) {
+		ht.Assert.PageOf(1, w.Body)
+	}
+
+	w = ht.Get("/effects?type=not_an_effect")
+	ht.Assert.Equal(400, w.Code)
+
 	// before history
 	ht.ReapHistory(1)
 	w = ht.Get("/effects?order=desc&cursor=8589938689-1")
@@ -1,6 +1,7 @@
 package horizon
 
 import (
+	"github.com/stellar/horizon/bus"
 	"github.com/stellar/horizon/db2"
 	"github.com/stellar/horizon/db2/history"
 	"github.com/stellar/horizon/ledger"
@@ -58,6 +59,64 @@ func (action *LedgerIndexAction) SSE(stream sse.Stream) {
 	)
 }
 
+// Topics is a method for actions.Watcher
+func (action *LedgerIndexAction) Topics() []string {
+	return []string{bus.TopicLedgers}
+}
+
+// SSEEvents is a method for actions.EventSSE.  Newly ingested ledgers are sent
+// straight from the published events when they directly follow the ledgers
+// already sent on an ascending stream.
+func (action *LedgerIndexAction) SSEEvents(stream sse.Stream, events []bus.Event) bool {
+	if action.Err != nil || action.PagingParams.Order != db2.OrderAscending {
+		return false
+	}
+
+	// the stream must be caught up with the records loaded for it
+	if len(action.Records) != stream.SentCount() {
+		return false
+	}
+
+	var after int64
+	if len(action.Records) > 0 {
+		after = action.Records[len(action.Records)-1].ID
+	} else {
+		after, action.Err = action.PagingParams.CursorInt64()
+		if action.Err != nil {
+			return false
+		}
+	}
+
+	var records []history.Ledger
+	for _, event := range events {
+		// skip ledgers that were already loaded by a query
+		if event.Ledger.ID <= after {
+			continue
+		}
+
+		// bail out to a query if there is a gap
+		if len(records) > 0 && event.Ledger.Sequence != records[len(records)-1].Sequence+1 {
+			return false
+		}
+
+		records = append(records, event.Ledger)
+	}
+
+	for _, record := range records {
+		action.Records = append(action.Records, record)
+
+		var res resource.Ledger
+		res.Populate(action.Ctx, record)
+		stream.Send(sse.Event{ID: res.PagingToken(), Data: res})
+
+		if stream.IsDone() {
+			break
+		}
+	}
+
+	return true
+}
+
 func (action *LedgerIndexAction) loadParams() {
 	action.ValidateCursorAsDefault()
 	action.PagingParams = action.GetPageQuery()
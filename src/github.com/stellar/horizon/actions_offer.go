@@ -3,12 +3,16 @@ package horizon
 import (
 	"github.com/stellar/horizon/db2"
 	"github.com/stellar/horizon/db2/core"
+	"github.com/stellar/horizon/db2/history"
 	"github.com/stellar/horizon/render/hal"
 	"github.com/stellar/horizon/render/sse"
 	"github.com/stellar/horizon/resource"
 )
 
 // This file contains the actions:
+//
+// OffersByAccountAction: pages of offers owned by an account
+// OfferShowAction: a single offer, active or historical
 
 // OffersByAccountAction renders a page of offer resources, for a given
 // account.  These offers are present in the ledger as of the latest validated
@@ -49,6 +53,11 @@ func (action *OffersByAccountAction) SSE(stream sse.Stream) {
 	)
 }
 
+// Topics is a method for actions.Watcher
+func (action *OffersByAccountAction) Topics() []string {
+	return accountTopics(action.GetString("account_id"))
+}
+
 func (action *OffersByAccountAction) loadParams() {
 	action.PageQuery = action.GetPageQuery()
 	action.Address = action.GetString("account_id")
@@ -76,3 +85,98 @@ func (action *OffersByAccountAction) loadPage() {
 	action.Page.Order = action.PageQuery.Order
 	action.Page.PopulateLinks()
 }
+
+// OfferShowAction renders a single offer.  Offers that are present in the ledger
+// are loaded from stellar-core.  Offers that have since been filled or
+// cancelled are reconstructed from the trades and effects recorded in the
+// history database.
+type OfferShowAction struct {
+	Action
+	OfferID int64
+	Active  bool
+	Record  core.Offer
+
+	// LastEffect is the latest offer effect of an offer no longer in the
+	// ledger, and LastTrade the latest trade made against it.
+	LastEffect *history.Effect
+	Trades     history.OfferTrades
+	LastTrade  *history.Trade
+}
+
+// JSON is a method for actions.JSON
+func (action *OfferShowAction) JSON() {
+	action.Do(
+		action.loadParams,
+		action.loadRecord,
+		action.loadHistory,
+		func() {
+			if action.Active {
+				var res resource.Offer
+				res.Populate(action.Ctx, action.Record)
+				hal.Render(action.W, res)
+				return
+			}
+
+			var res resource.HistoricalOffer
+			action.Err = res.Populate(
+				action.Ctx,
+				action.OfferID,
+				action.LastEffect,
+				action.Trades,
+				action.LastTrade,
+			)
+			if action.Err != nil {
+				return
+			}
+			hal.Render(action.W, res)
+		},
+	)
+}
+
+func (action *OfferShowAction) loadParams() {
+	action.OfferID = action.GetInt64("id")
+}
+
+func (action *OfferShowAction) loadRecord() {
+	err := action.CoreQ().OfferByID(&action.Record, action.OfferID)
+	if action.CoreQ().NoRows(err) {
+		return
+	}
+
+	if err != nil {
+		action.Err = err
+		return
+	}
+
+	action.Active = true
+}
+
+// loadHistory loads the offer's latest offer effect and the totals of the
+// trades made against it.  The latest trade is only loaded when the offer has
+// no offer effects, as history ingested before they were recorded does not.
+func (action *OfferShowAction) loadHistory() {
+	if action.Active {
+		return
+	}
+
+	q := action.HistoryQ()
+
+	var effect history.Effect
+	err := q.LastOfferEffect(&effect, action.OfferID)
+	switch {
+	case q.NoRows(err):
+	case err != nil:
+		action.Err = err
+		return
+	default:
+		action.LastEffect = &effect
+	}
+
+	action.Err = q.OfferTradesByID(&action.Trades, action.OfferID)
+	if action.Err != nil || action.LastEffect != nil || action.Trades.Count == 0 {
+		return
+	}
+
+	action.LastTrade = &history.Trade{}
+	action.Err = q.LastOfferTrade(action.LastTrade, action.OfferID)
+}
@@ -1,7 +1,10 @@
 package horizon
 
 import (
+	"encoding/json"
 	"testing"
+
+	"github.com/stellar/horizon/resource"
 )
 
 func TestOfferActions_Index(t *testing.T) {
@@ -16,3 +19,70 @@ func TestOfferActions_Index(t *testing.T) {
 		ht.Assert.PageOf(3, w.Body)
 	}
 }
+
+func TestOfferActions_Show(t *testing.T) {
+	ht := StartHTTPTest(t, "trades")
+	defer ht.Finish()
+
+	// offer in the ledger
+	w := ht.Get("/offers/1")
+	if ht.Assert.Equal(200, w.Code) {
+		var result resource.Offer
+		err := json.Unmarshal(w.Body.Bytes(), &result)
+		ht.Require.NoError(err)
+		ht.Assert.Equal(int64(1), result.ID)
+		ht.Assert.Equal("GA5WBPYA5Y4WAEHXWR2UKO2UO4BUGHUQ74EUPKON2QHV4WRHOIRNKKH2", result.Seller)
+		ht.Assert.Equal("50.0000000", result.Amount)
+	}
+
+	// unknown offer
+	w = ht.Get("/offers/100")
+	ht.Assert.Equal(404, w.Code)
+
+	// removed offer that only has trades recorded
+	_, err := ht.CoreSession().ExecRaw("DELETE FROM offers WHERE offerid = 1")
+	ht.Require.NoError(err)
+
+	w = ht.Get("/offers/1")
+	if ht.Assert.Equal(200, w.Code) {
+		var result resource.HistoricalOffer
+		err := json.Unmarshal(w.Body.Bytes(), &result)
+		ht.Require.NoError(err)
+		ht.Assert.Equal(resource.OfferStatusPartiallyFilled, result.Status)
+		ht.Assert.Equal("GA5WBPYA5Y4WAEHXWR2UKO2UO4BUGHUQ74EUPKON2QHV4WRHOIRNKKH2", result.Seller)
+		ht.Assert.Equal("EUR", result.Selling.Code)
+		ht.Assert.Equal("USD", result.Buying.Code)
+		ht.Assert.Equal("50.0000000", result.AmountSold)
+		ht.Assert.Equal("50.0000000", result.AmountBought)
+	}
+
+	// removed by the operation that traded against it
+	_, err = ht.HorizonSession().ExecRaw(`
+		INSERT INTO history_effects
+			(history_account_id, history_operation_id, "order", type, details)
+		VALUES
+			(3, 25769807873, 10, 31, ?)
+	`, `{
+		"offer_id": 1,
+		"amount": "50.0000000",
+		"price": "1.0000000",
+		"price_r": {"n": 1, "d": 1},
+		"selling_asset_type": "credit_alphanum4",
+		"selling_asset_code": "EUR",
+		"selling_asset_issuer": "GCQPYGH4K57XBDENKKX55KDTWOTK5WDWRQOH2LHEDX3EKVIQRLMESGBG",
+		"buying_asset_type": "credit_alphanum4",
+		"buying_asset_code": "USD",
+		"buying_asset_issuer": "GC23QF2HUE52AMXUFUH3AYJAXXGXXV2VHXYYR6EYXETPKDXZSAW67XO4"
+	}`)
+	ht.Require.NoError(err)
+
+	w = ht.Get("/offers/1")
+	if ht.Assert.Equal(200, w.Code) {
+		var result resource.HistoricalOffer
+		err := json.Unmarshal(w.Body.Bytes(), &result)
+		ht.Require.NoError(err)
+		ht.Assert.Equal(resource.OfferStatusFilled, result.Status)
+		ht.Assert.Equal("0.0000000", result.Amount)
+		ht.Assert.Equal("1.0000000", result.Price)
+	}
+}
@@ -27,6 +27,7 @@ type OperationIndexAction struct {
 	LedgerFilter      int32
 	AccountFilter     string
 	TransactionFilter string
+	IncludeFailed     bool
 	PagingParams      db2.PageQuery
 	Records           []history.Operation
 	Ledgers           history.LedgerCache
@@ -85,12 +86,24 @@ func (action *OperationIndexAction) SSE(stream sse.Stream) {
 
 }
 
+// Topics is a method for actions.Watcher
+func (action *OperationIndexAction) Topics() []string {
+	return accountTopics(action.GetString("account_id"))
+}
+
 func (action *OperationIndexAction) loadParams() {
 	action.ValidateCursorAsDefault()
 	action.AccountFilter = action.GetString("account_id")
 	action.LedgerFilter = action.GetInt32("ledger_id")
 	action.TransactionFilter = action.GetString("tx_id")
+	action.IncludeFailed = action.GetBool("include_failed")
 	action.PagingParams = action.GetPageQuery()
+
+	// a client asking for the operations of a specific transaction already
+	// knows whether it failed, so return them either way.
+	if action.TransactionFilter != "" {
+		action.IncludeFailed = true
+	}
 }
 
 func (action *OperationIndexAction) loadRecords() {
@@ -106,6 +119,10 @@ func (action *OperationIndexAction) loadRecords() {
 		ops.ForTransaction(action.TransactionFilter)
 	}
 
+	if action.IncludeFailed {
+		ops.IncludeFailed()
+	}
+
 	action.Err = ops.Page(action.PagingParams).Select(&action.Records)
 }
 
@@ -6,6 +6,7 @@ import (
 	"net/http"
 
 	"github.com/stellar/go/xdr"
+	"github.com/stellar/horizon/bus"
 	"github.com/stellar/horizon/db2"
 	"github.com/stellar/horizon/db2/core"
 	"github.com/stellar/horizon/db2/history"
@@ -84,6 +85,11 @@ func (action *OrderBookShowAction) SSE(stream sse.Stream) {
 
 }
 
+// Topics is a method for actions.Watcher
+func (action *OrderBookShowAction) Topics() []string {
+	return []string{bus.TopicLedgers}
+}
+
 type OrderBookTradeIndexAction struct {
 	Action
 	Selling      xdr.Asset
@@ -1,11 +1,17 @@
 package horizon
 
 import (
+	"github.com/stellar/go/xdr"
 	"github.com/stellar/horizon/paths"
 	"github.com/stellar/horizon/render/hal"
 	"github.com/stellar/horizon/resource"
 )
 
+// This file contains the actions:
+//
+// PathIndexAction: finds paths that deliver a fixed destination amount
+// PathStrictSendAction: finds paths that send a fixed source amount
+
 // PathIndexAction provides path finding
 type PathIndexAction struct {
 	Action
@@ -18,6 +24,7 @@ type PathIndexAction struct {
 func (action *PathIndexAction) JSON() {
 	action.Do(
 		action.loadQuery,
+		action.loadDestinationAssets,
 		action.loadSourceAssets,
 		action.loadRecords,
 		action.loadPage,
@@ -29,12 +36,39 @@ func (action *PathIndexAction) JSON() {
 
 func (action *PathIndexAction) loadQuery() {
 	action.Query.DestinationAmount = action.GetAmount("destination_amount")
-	action.Query.DestinationAddress = action.GetAddress("destination_account")
-	action.Query.DestinationAsset = action.GetAsset("destination_")
+	action.Query.DestinationAssets = action.GetAssets("destination_assets")
+	if len(action.Query.DestinationAssets) == 0 && action.GetString("destination_asset_type") != "" {
+		action.Query.DestinationAssets = []xdr.Asset{action.GetAsset("destination_")}
+	}
+	// the destination account is only needed to find the assets it can hold
+	if len(action.Query.DestinationAssets) == 0 {
+		action.Query.DestinationAddress = action.GetAddress("destination_account")
+	}
+	action.Query.SourceAssets = action.GetAssets("source_assets")
+	action.Query.MaxPaths = int(action.GetLimit("max_paths", paths.DefaultMaxPaths, paths.MaxPathsLimit))
+	action.Query.MaxHops = int(action.GetLimit("max_hops", paths.MaxHopsLimit, paths.MaxHopsLimit))
+}
+
+// loadDestinationAssets loads the assets trusted by the destination account,
+// unless the destination assets were given explicitly.
+func (action *PathIndexAction) loadDestinationAssets() {
+	if len(action.Query.DestinationAssets) > 0 {
+		return
+	}
 
+	action.Err = action.CoreQ().AssetsForAddress(
+		&action.Query.DestinationAssets,
+		action.Query.DestinationAddress,
+	)
 }
 
+// loadSourceAssets loads the assets trusted by the source account, unless the
+// source assets were given explicitly.
 func (action *PathIndexAction) loadSourceAssets() {
+	if len(action.Query.SourceAssets) > 0 {
+		return
+	}
+
 	action.Err = action.CoreQ().AssetsForAddress(
 		&action.Query.SourceAssets,
 		action.GetAddress("source_account"),
@@ -56,3 +90,68 @@ func (action *PathIndexAction) loadPage() {
 		action.Page.Add(res)
 	}
 }
+
+// PathStrictSendAction provides strict-send path finding: given an amount of a
+// source asset, it finds the paths to the destination assets, the assets
+// trusted by the destination account or, without either, any asset, and how
+// much of each would be received.
+type PathStrictSendAction struct {
+	Action
+	Query   paths.Query
+	Records []paths.Path
+	Page    hal.BasePage
+}
+
+// JSON implements actions.JSON
+func (action *PathStrictSendAction) JSON() {
+	action.Do(
+		action.loadQuery,
+		action.loadDestinationAssets,
+		action.loadRecords,
+		action.loadPage,
+		func() {
+			hal.Render(action.W, action.Page)
+		},
+	)
+}
+
+func (action *PathStrictSendAction) loadQuery() {
+	action.Query.SourceAmount = action.GetAmount("source_amount")
+	action.Query.SourceAsset = action.GetAsset("source_")
+	action.Query.DestinationAssets = action.GetAssets("destination_assets")
+	if len(action.Query.DestinationAssets) == 0 && action.GetString("destination_account") != "" {
+		action.Query.DestinationAddress = action.GetAddress("destination_account")
+	}
+	action.Query.MaxPaths = int(action.GetLimit("max_paths", paths.DefaultMaxPaths, paths.MaxPathsLimit))
+	action.Query.MaxHops = int(action.GetLimit("max_hops", paths.MaxHopsLimit, paths.MaxHopsLimit))
+}
+
+// loadDestinationAssets loads the assets trusted by the destination account,
+// unless the destination assets were given explicitly.  Without either, the
+// search is for paths to any asset.
+func (action *PathStrictSendAction) loadDestinationAssets() {
+	if len(action.Query.DestinationAssets) > 0 || action.Query.DestinationAddress == "" {
+		return
+	}
+
+	action.Err = action.CoreQ().AssetsForAddress(
+		&action.Query.DestinationAssets,
+		action.Query.DestinationAddress,
+	)
+}
+
+func (action *PathStrictSendAction) loadRecords() {
+	action.Records, action.Err = action.App.paths.FindStrictSend(action.Query)
+}
+
+func (action *PathStrictSendAction) loadPage() {
+	action.Page.Init()
+	for _, p := range action.Records {
+		var res resource.Path
+		action.Err = res.PopulateStrictSend(action.Ctx, action.Query, p)
+		if action.Err != nil {
+			return
+		}
+		action.Page.Add(res)
+	}
+}
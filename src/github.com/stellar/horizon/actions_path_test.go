@@ -3,6 +3,8 @@ package horizon
 import (
 	"net/url"
 	"testing"
+
+	"github.com/stellar/horizon/resource"
 )
 
 func TestPathActions_Index(t *testing.T) {
@@ -36,4 +38,125 @@ func TestPathActions_Index(t *testing.T) {
 	ht.Assert.Equal(200, w.Code)
 	ht.Assert.PageOf(3, w.Body)
 
+	// the cheapest path is returned first, with its price and slippage
+	q.Set("max_paths", "1")
+	w = ht.Get("/paths?" + q.Encode())
+	if ht.Assert.Equal(200, w.Code) {
+		ht.Assert.PageOf(1, w.Body)
+
+		var records []resource.Path
+		ht.UnmarshalPage(w.Body, &records)
+		ht.Assert.Equal("5.0000000", records[0].SourceAmount)
+		ht.Assert.Equal("0.5000000", records[0].Price)
+		ht.Assert.Equal("0.0000000", records[0].Slippage)
+	}
+
+	// limits are capped
+	q.Set("max_paths", "21")
+	w = ht.Get("/paths?" + q.Encode())
+	ht.Assert.Equal(400, w.Code)
+
+	q.Set("max_paths", "5")
+	q.Set("max_hops", "7")
+	w = ht.Get("/paths?" + q.Encode())
+	ht.Assert.Equal(400, w.Code)
+
+	q.Set("max_hops", "1")
+	w = ht.Get("/paths?" + q.Encode())
+	ht.Assert.Equal(200, w.Code)
+	ht.Assert.PageOf(1, w.Body)
+
+	// explicit source and destination assets, which need no destination
+	// account
+	issuer := "GDSBCQO34HWPGUGQSP3QBFEXVTSR2PW46UIGTHVWGWJGQKH3AFNHXHXN"
+	q = make(url.Values)
+	q.Add("destination_assets", "EUR:"+issuer+",native")
+	q.Add("source_assets", "USD:"+issuer)
+	q.Add("destination_amount", "10")
+
+	w = ht.Get("/paths?" + q.Encode())
+	if ht.Assert.Equal(200, w.Code) {
+		ht.Assert.PageOf(4, w.Body)
+
+		var records []resource.Path
+		ht.UnmarshalPage(w.Body, &records)
+		ht.Assert.Equal("native", records[0].DestinationAssetType)
+	}
+
+	// destination assets default to those trusted by the destination account
+	q.Del("destination_assets")
+	w = ht.Get("/paths?" + q.Encode())
+	ht.Assert.Equal(400, w.Code)
+
+	q.Add(
+		"destination_account",
+		"GAEDTJ4PPEFVW5XV2S7LUXBEHNQMX5Q2GM562RJGOQG7GVCE5H3HIB4V",
+	)
+	w = ht.Get("/paths?" + q.Encode())
+	ht.Assert.Equal(200, w.Code)
+	ht.Assert.PageOf(4, w.Body)
+
+	q.Set("source_assets", "USD")
+	w = ht.Get("/paths?" + q.Encode())
+	ht.Assert.Equal(400, w.Code)
+}
+
+func TestPathActions_StrictSend(t *testing.T) {
+	ht := StartHTTPTest(t, "paths")
+	defer ht.Finish()
+
+	// no query args
+	w := ht.Get("/paths/strict-send")
+	ht.Assert.Equal(400, w.Code)
+
+	// happy path
+	var q = make(url.Values)
+
+	q.Add(
+		"destination_account",
+		"GAEDTJ4PPEFVW5XV2S7LUXBEHNQMX5Q2GM562RJGOQG7GVCE5H3HIB4V",
+	)
+	q.Add(
+		"source_asset_issuer",
+		"GDSBCQO34HWPGUGQSP3QBFEXVTSR2PW46UIGTHVWGWJGQKH3AFNHXHXN",
+	)
+	q.Add("source_asset_type", "credit_alphanum4")
+	q.Add("source_asset_code", "USD")
+	q.Add("source_amount", "30")
+
+	w = ht.Get("/paths/strict-send?" + q.Encode())
+	ht.Assert.Equal(200, w.Code)
+	ht.Assert.PageOf(3, w.Body)
+
+	var records []resource.Path
+	ht.UnmarshalPage(w.Body, &records)
+	for _, record := range records {
+		ht.Assert.Equal("30.0000000", record.SourceAmount)
+		ht.Assert.Equal("USD", record.SourceAssetCode)
+	}
+
+	// results are ordered by the amount received, and so the direct path to
+	// native comes before the direct path to EUR, which takes both offers at 0.5
+	q.Set("source_amount", "10")
+	q.Set("max_hops", "1")
+	w = ht.Get("/paths/strict-send?" + q.Encode())
+	if ht.Assert.Equal(200, w.Code) {
+		ht.UnmarshalPage(w.Body, &records)
+		if ht.Assert.Len(records, 2) {
+			ht.Assert.Equal("EUR", records[1].DestinationAssetCode)
+			ht.Assert.Equal("20.0000000", records[1].DestinationAmount)
+			ht.Assert.Equal("0.5000000", records[1].Price)
+			ht.Assert.Equal("0.0000000", records[1].Slippage)
+		}
+	}
+
+	// without a destination account or assets, paths to every asset that can
+	// be reached are found, including those the account does not trust
+	q.Del("destination_account")
+	q.Set("max_paths", "20")
+	w = ht.Get("/paths/strict-send?" + q.Encode())
+	if ht.Assert.Equal(200, w.Code) {
+		ht.UnmarshalPage(w.Body, &records)
+		ht.Assert.True(len(records) > 2)
+	}
 }
@@ -18,6 +18,7 @@ type PaymentsIndexAction struct {
 	LedgerFilter      int32
 	AccountFilter     string
 	TransactionFilter string
+	IncludeFailed     bool
 	PagingParams      db2.PageQuery
 	Records           []history.Operation
 	Ledgers           history.LedgerCache
@@ -76,12 +77,24 @@ func (action *PaymentsIndexAction) SSE(stream sse.Stream) {
 		})
 }
 
+// Topics is a method for actions.Watcher
+func (action *PaymentsIndexAction) Topics() []string {
+	return accountTopics(action.GetString("account_id"))
+}
+
 func (action *PaymentsIndexAction) loadParams() {
 	action.ValidateCursorAsDefault()
 	action.AccountFilter = action.GetString("account_id")
 	action.LedgerFilter = action.GetInt32("ledger_id")
 	action.TransactionFilter = action.GetString("tx_id")
+	action.IncludeFailed = action.GetBool("include_failed")
 	action.PagingParams = action.GetPageQuery()
+
+	// a client asking for the operations of a specific transaction already
+	// knows whether it failed, so return them either way.
+	if action.TransactionFilter != "" {
+		action.IncludeFailed = true
+	}
 }
 
 func (action *PaymentsIndexAction) loadRecords() {
@@ -97,6 +110,10 @@ func (action *PaymentsIndexAction) loadRecords() {
 		ops.ForTransaction(action.TransactionFilter)
 	}
 
+	if action.IncludeFailed {
+		ops.IncludeFailed()
+	}
+
 	action.Err = ops.Page(action.PagingParams).Select(&action.Records)
 }
 
@@ -16,6 +16,11 @@ type RootAction struct {
 func (action *RootAction) JSON() {
 	action.App.UpdateStellarCoreInfo()
 
+	var ingestRole string
+	if action.App.ingester != nil {
+		ingestRole = action.App.ingester.Role()
+	}
+
 	var res resource.Root
 	res.Populate(
 		action.Ctx,
@@ -24,6 +29,7 @@ func (action *RootAction) JSON() {
 		action.App.coreVersion,
 		action.App.networkPassphrase,
 		action.App.protocolVersion,
+		ingestRole,
 	)
 
 	hal.Render(action.W, res)
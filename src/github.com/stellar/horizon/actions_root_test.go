@@ -31,5 +31,8 @@ func TestRootAction(t *testing.T) {
 		ht.Require.NoError(err)
 		ht.Assert.Equal("test-horizon", actual.HorizonVersion)
 		ht.Assert.Equal("test-core", actual.StellarCoreVersion)
+
+		// this server does not ingest
+		ht.Assert.Empty(actual.IngestRole)
 	}
 }
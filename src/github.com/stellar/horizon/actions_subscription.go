@@ -0,0 +1,472 @@
+package horizon
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/stellar/go/xdr"
+	"github.com/stellar/horizon/assets"
+	"github.com/stellar/horizon/bus"
+	"github.com/stellar/horizon/db2"
+	"github.com/stellar/horizon/db2/core"
+	"github.com/stellar/horizon/db2/history"
+	"github.com/stellar/horizon/ledger"
+	"github.com/stellar/horizon/render/sse"
+	"github.com/stellar/horizon/resource"
+	"github.com/stellar/horizon/toid"
+)
+
+// This file contains the actions:
+//
+// SubscriptionsAction: a single stream multiplexing several subscriptions
+
+// MaxSubscriptions is the largest number of subscriptions that can be
+// multiplexed onto a single stream.
+const MaxSubscriptions = 1000
+
+const (
+	subscriptionLedgers   = "ledgers"
+	subscriptionPayments  = "payments"
+	subscriptionEffects   = "effects"
+	subscriptionOrderBook = "order_book"
+)
+
+// SubscriptionsAction streams the records for several subscriptions over a
+// single connection.  Each subscription is provided as a `subscribe` parameter
+// of the form:
+//
+//	ledgers[@cursor]
+//	payments/<account>[@cursor]
+//	effects/<account>[@cursor]
+//	order_book/<selling>/<buying>
+//
+// where assets are either `native` or `<code>:<issuer>`.  Every record is sent
+// tagged with the subscription (less its cursor) it belongs to.  The id of
+// each event records the cursor of the event's subscription, so that a client
+// reconnecting with the Last-Event-ID header resumes that subscription where
+// it left off.  A client resumes the others by updating the cursors of its
+// `subscribe` parameters.
+type SubscriptionsAction struct {
+	Action
+	Subscriptions []*subscription
+}
+
+type subscription struct {
+	// Index is the position of the subscription in the request.
+	Index   int
+	Tag     string
+	Kind    string
+	Account string
+	Selling xdr.Asset
+	Buying  xdr.Asset
+
+	// Requested is the cursor provided in the request, and Cursor the paging
+	// token of the last record sent for this subscription.
+	Requested string
+	Cursor    string
+
+	// book is the last order book summary sent for this subscription
+	book []byte
+}
+
+// SSE is a method for actions.SSE
+func (action *SubscriptionsAction) SSE(stream sse.Stream) {
+	action.Setup(
+		action.EnsureHistoryFreshness,
+		action.loadParams,
+	)
+	action.Do(func() {
+		for _, sub := range action.Subscriptions {
+			action.send(stream, sub)
+
+			if action.Err != nil || stream.IsDone() {
+				return
+			}
+		}
+	})
+}
+
+// Topics is a method for actions.Watcher
+func (action *SubscriptionsAction) Topics() []string {
+	action.Setup(
+		action.EnsureHistoryFreshness,
+		action.loadParams,
+	)
+
+	var topics []string
+	for _, sub := range action.Subscriptions {
+		if sub.Account == "" {
+			topics = append(topics, bus.TopicLedgers)
+		} else {
+			topics = append(topics, bus.AccountTopic(sub.Account))
+		}
+	}
+
+	if len(topics) == 0 {
+		return []string{bus.TopicLedgers}
+	}
+
+	return topics
+}
+
+// SSEEvents is a method for actions.EventSSE.  Only the subscriptions whose
+// account participated in one of the ingested ledgers are re-queried.
+func (action *SubscriptionsAction) SSEEvents(stream sse.Stream, events []bus.Event) bool {
+	if action.Err != nil {
+		return false
+	}
+
+	touched := map[string]bool{}
+	for _, event := range events {
+		for _, account := range event.Accounts {
+			touched[account] = true
+		}
+	}
+
+	for _, sub := range action.Subscriptions {
+		if sub.Account != "" && !touched[sub.Account] {
+			continue
+		}
+
+		action.send(stream, sub)
+
+		if action.Err != nil || stream.IsDone() {
+			break
+		}
+	}
+
+	return true
+}
+
+func (action *SubscriptionsAction) loadParams() {
+	values := action.GetStrings("subscribe")
+
+	switch {
+	case len(values) == 0:
+		action.SetInvalidField("subscribe", errors.New("at least one subscription is required"))
+		return
+	case len(values) > MaxSubscriptions:
+		action.SetInvalidField("subscribe", fmt.Errorf("no more than %d subscriptions are allowed", MaxSubscriptions))
+		return
+	}
+
+	action.Subscriptions = make([]*subscription, len(values))
+	for i, value := range values {
+		sub, err := parseSubscription(value)
+		if err != nil {
+			action.SetInvalidField("subscribe", err)
+			return
+		}
+
+		sub.Index = i
+		action.Subscriptions[i] = sub
+	}
+
+	// resume the subscription of the last event the client received
+	if lei := action.R.Header.Get("Last-Event-ID"); lei != "" {
+		i, cursor, err := parseSubscriptionCursor(lei, action.Subscriptions)
+		if err != nil {
+			action.SetInvalidField("Last-Event-ID", err)
+			return
+		}
+
+		action.Subscriptions[i].Cursor = cursor
+	}
+}
+
+// send streams every record for `sub` that follows its cursor.
+func (action *SubscriptionsAction) send(stream sse.Stream, sub *subscription) {
+	if sub.Kind == subscriptionOrderBook {
+		action.sendOrderBook(stream, sub)
+		return
+	}
+
+	for {
+		var (
+			n   int
+			err error
+		)
+
+		pq := db2.PageQuery{
+			Cursor: sub.Cursor,
+			Order:  db2.OrderAscending,
+			Limit:  db2.MaxPageSize,
+		}
+
+		switch sub.Kind {
+		case subscriptionLedgers:
+			n, err = action.sendLedgers(stream, sub, pq)
+		case subscriptionPayments:
+			n, err = action.sendPayments(stream, sub, pq)
+		case subscriptionEffects:
+			n, err = action.sendEffects(stream, sub, pq)
+		}
+
+		if err != nil {
+			action.Err = err
+			return
+		}
+
+		// keep going until the subscription has caught up
+		if uint64(n) < pq.Limit || stream.IsDone() || action.Ctx.Err() != nil {
+			return
+		}
+	}
+}
+
+func (action *SubscriptionsAction) sendLedgers(
+	stream sse.Stream,
+	sub *subscription,
+	pq db2.PageQuery,
+) (int, error) {
+	var records []history.Ledger
+	err := action.HistoryQ().Ledgers().Page(pq).Select(&records)
+	if err != nil {
+		return 0, err
+	}
+
+	for _, record := range records {
+		var res resource.Ledger
+		res.Populate(action.Ctx, record)
+		action.sendRecord(stream, sub, res.PagingToken(), res)
+	}
+
+	return len(records), nil
+}
+
+func (action *SubscriptionsAction) sendPayments(
+	stream sse.Stream,
+	sub *subscription,
+	pq db2.PageQuery,
+) (int, error) {
+	var records []history.Operation
+	err := action.HistoryQ().Operations().
+		OnlyPayments().
+		ForAccount(sub.Account).
+		Page(pq).
+		Select(&records)
+	if err != nil {
+		return 0, err
+	}
+
+	var ledgers history.LedgerCache
+	for _, record := range records {
+		ledgers.Queue(record.LedgerSequence())
+	}
+
+	err = ledgers.Load(action.HistoryQ())
+	if err != nil {
+		return 0, err
+	}
+
+	for _, record := range records {
+		ledger, found := ledgers.Records[record.LedgerSequence()]
+		if !found {
+			return 0, fmt.Errorf("could not find ledger data for sequence %d", record.LedgerSequence())
+		}
+
+		res, err := resource.NewOperation(action.Ctx, record, ledger)
+		if err != nil {
+			return 0, err
+		}
+
+		action.sendRecord(stream, sub, res.PagingToken(), res)
+	}
+
+	return len(records), nil
+}
+
+func (action *SubscriptionsAction) sendEffects(
+	stream sse.Stream,
+	sub *subscription,
+	pq db2.PageQuery,
+) (int, error) {
+	var records []history.Effect
+	err := action.HistoryQ().Effects().
+		ForAccount(sub.Account).
+		Page(pq).
+		Select(&records)
+	if err != nil {
+		return 0, err
+	}
+
+	for _, record := range records {
+		res, err := resource.NewEffect(action.Ctx, record)
+		if err != nil {
+			return 0, err
+		}
+
+		action.sendRecord(stream, sub, res.PagingToken(), res)
+	}
+
+	return len(records), nil
+}
+
+// sendOrderBook sends the summary of the subscription's order book, if it has
+// changed since it was last sent.
+func (action *SubscriptionsAction) sendOrderBook(stream sse.Stream, sub *subscription) {
+	var (
+		record core.OrderBookSummary
+		res    resource.OrderBookSummary
+	)
+
+	action.Err = action.CoreQ().GetOrderBookSummary(&record, sub.Selling, sub.Buying)
+	if action.Err != nil {
+		return
+	}
+
+	action.Err = res.Populate(action.Ctx, sub.Selling, sub.Buying, record)
+	if action.Err != nil {
+		return
+	}
+
+	book, err := json.Marshal(res)
+	if err != nil {
+		action.Err = err
+		return
+	}
+
+	if string(book) == string(sub.book) {
+		return
+	}
+
+	sub.book = book
+	action.sendRecord(stream, sub, sub.Cursor, res)
+}
+
+// sendRecord advances the subscription to `cursor` and sends `res` tagged
+// with the subscription.
+func (action *SubscriptionsAction) sendRecord(
+	stream sse.Stream,
+	sub *subscription,
+	cursor string,
+	res interface{},
+) {
+	sub.Cursor = cursor
+	stream.Send(sse.Event{
+		ID: sub.eventID(),
+		Data: resource.SubscriptionEvent{
+			Subscription: sub.Tag,
+			Record:       res,
+		},
+	})
+}
+
+// eventID encodes the cursor of the subscription as `<index>=<cursor>`, where
+// index is the position of the subscription in the request.  Order book
+// subscriptions have no cursor, so their events have no id.
+func (sub *subscription) eventID() string {
+	if sub.Cursor == "" {
+		return ""
+	}
+
+	return fmt.Sprintf("%d=%s", sub.Index, sub.Cursor)
+}
+
+// parseSubscription parses a single `subscribe` parameter.
+func parseSubscription(value string) (*subscription, error) {
+	sub := &subscription{}
+
+	if at := strings.LastIndex(value, "@"); at != -1 {
+		sub.Requested = value[at+1:]
+		value = value[:at]
+	}
+
+	sub.Tag = value
+	parts := strings.Split(value, "/")
+	sub.Kind = parts[0]
+
+	switch sub.Kind {
+	case subscriptionLedgers:
+		if len(parts) != 1 {
+			return nil, fmt.Errorf("%s: unexpected arguments", value)
+		}
+	case subscriptionPayments, subscriptionEffects:
+		if len(parts) != 2 {
+			return nil, fmt.Errorf("%s: expected an account", value)
+		}
+
+		var aid xdr.AccountId
+		if err := aid.SetAddress(parts[1]); err != nil {
+			return nil, fmt.Errorf("%s: invalid account", value)
+		}
+		sub.Account = parts[1]
+	case subscriptionOrderBook:
+		if len(parts) != 3 {
+			return nil, fmt.Errorf("%s: expected a selling and buying asset", value)
+		}
+
+		if sub.Requested != "" {
+			return nil, fmt.Errorf("%s: order books do not take a cursor", value)
+		}
+
+		var err error
+		sub.Selling, err = assets.FromString(parts[1])
+		if err != nil {
+			return nil, fmt.Errorf("%s: %s", value, err)
+		}
+
+		sub.Buying, err = assets.FromString(parts[2])
+		if err != nil {
+			return nil, fmt.Errorf("%s: %s", value, err)
+		}
+	default:
+		return nil, fmt.Errorf("%s: unknown subscription type", value)
+	}
+
+	switch sub.Requested {
+	case "":
+	case "now":
+		tid := toid.AfterLedger(ledger.CurrentState().HistoryLatest)
+		sub.Cursor = tid.String()
+	default:
+		if err := sub.checkCursor(sub.Requested); err != nil {
+			return nil, fmt.Errorf("%s: invalid cursor", value)
+		}
+		sub.Cursor = sub.Requested
+	}
+
+	return sub, nil
+}
+
+// checkCursor returns an error if `cursor` is not a paging token of the
+// records of the subscription: an operation id and order pair for effects,
+// and an id for ledgers and payments.  Order books take no cursor.
+func (sub *subscription) checkCursor(cursor string) error {
+	pq := db2.PageQuery{Cursor: cursor, Order: db2.OrderAscending}
+
+	var err error
+	switch sub.Kind {
+	case subscriptionEffects:
+		_, _, err = pq.CursorInt64Pair(db2.DefaultPairSep)
+	case subscriptionOrderBook:
+		err = errors.New("order books do not take a cursor")
+	default:
+		_, err = pq.CursorInt64()
+	}
+
+	return err
+}
+
+// parseSubscriptionCursor parses an event id produced by eventID, returning
+// the index of the subscription in `subs` and its cursor.
+func parseSubscriptionCursor(id string, subs []*subscription) (int, string, error) {
+	kv := strings.SplitN(id, "=", 2)
+	if len(kv) != 2 {
+		return 0, "", fmt.Errorf("invalid cursor %s", id)
+	}
+
+	i, err := strconv.Atoi(kv[0])
+	if err != nil || i < 0 || i >= len(subs) {
+		return 0, "", fmt.Errorf("invalid subscription index %s", kv[0])
+	}
+
+	if err := subs[i].checkCursor(kv[1]); err != nil {
+		return 0, "", fmt.Errorf("invalid cursor %s", kv[1])
+	}
+
+	return i, kv[1], nil
+}
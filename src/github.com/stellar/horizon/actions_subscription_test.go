@@ -0,0 +1,271 @@
+package horizon
+
+import (
+	"net/http"
+	"net/url"
+	"testing"
+
+	"github.com/stellar/horizon/actions"
+	"github.com/stellar/horizon/db2"
+	"github.com/stellar/horizon/db2/history"
+	"github.com/stellar/horizon/render/sse"
+	"github.com/stellar/horizon/resource"
+	"github.com/stellar/horizon/test"
+)
+
+func TestSubscriptionsActions(t *testing.T) {
+	ht := StartHTTPTest(t, "base")
+	defer ht.Finish()
+
+	master := "GBRPYHIL2CI3FNQ4BXLFMNDLFJUNPU2HY3ZMFSHONUCEOASW7QC7OX2H"
+	query := url.Values{"subscribe": {
+		"ledgers",
+		"payments/" + master,
+		"order_book/native/USD:" + master,
+	}}
+
+	var payments []history.Operation
+	err := ht.App.HistoryQ().Operations().
+		OnlyPayments().
+		ForAccount(master).
+		Select(&payments)
+	ht.Require.NoError(err)
+
+	action := subscriptionsAction(ht, query, "")
+	stream := &testStream{}
+	action.SSE(stream)
+	ht.Require.NoError(action.Err)
+
+	count := map[string]int{}
+	for _, e := range stream.Events {
+		count[e.Data.(resource.SubscriptionEvent).Subscription]++
+	}
+	ht.Assert.Equal(3, count["ledgers"])
+	ht.Assert.Equal(len(payments), count["payments/"+master])
+	ht.Assert.Equal(1, count["order_book/native/USD:"+master])
+
+	// each event records the cursor of its own subscription, and order book
+	// events have no id
+	var lastPayment sse.Event
+	for _, e := range stream.Events {
+		switch e.Data.(resource.SubscriptionEvent).Subscription {
+		case "payments/" + master:
+			lastPayment = e
+		case "order_book/native/USD:" + master:
+			ht.Assert.Equal("", e.ID)
+		}
+	}
+	ht.Assert.Equal("1="+stream.lastID("payments/"+master), lastPayment.ID)
+
+	// nothing new is sent when nothing changed
+	sent := len(stream.Events)
+	action.SSE(stream)
+	ht.Require.NoError(action.Err)
+	ht.Assert.Len(stream.Events, sent)
+
+	// reconnecting resumes the subscription of the last event received, while
+	// the others resume from the cursors they are given
+	resumed := url.Values{"subscribe": {
+		"ledgers@" + stream.lastID("ledgers"),
+		"payments/" + master,
+		"order_book/native/USD:" + master,
+	}}
+	action = subscriptionsAction(ht, resumed, lastPayment.ID)
+	stream = &testStream{}
+	action.SSE(stream)
+	ht.Require.NoError(action.Err)
+	if ht.Assert.Len(stream.Events, 1) {
+		ht.Assert.Equal(
+			"order_book/native/USD:"+master,
+			stream.Events[0].Data.(resource.SubscriptionEvent).Subscription,
+		)
+	}
+
+	// invalid subscriptions
+	w := ht.Get("/subscriptions?subscribe=bogus", test.RequestHelperStreaming)
+	ht.Assert.Equal(400, w.Code)
+	w = ht.Get("/subscriptions?subscribe=payments/bogus", test.RequestHelperStreaming)
+	ht.Assert.Equal(400, w.Code)
+	w = ht.Get("/subscriptions", test.RequestHelperStreaming)
+	ht.Assert.Equal(400, w.Code)
+
+	// only streaming is supported
+	w = ht.Get("/subscriptions?subscribe=ledgers")
+	ht.Assert.Equal(406, w.Code)
+}
+
+func TestSubscriptionsActions_Effects(t *testing.T) {
+	ht := StartHTTPTest(t, "base")
+	defer ht.Finish()
+
+	master := "GBRPYHIL2CI3FNQ4BXLFMNDLFJUNPU2HY3ZMFSHONUCEOASW7QC7OX2H"
+	tag := "effects/" + master
+
+	var effects []history.Effect
+	err := ht.App.HistoryQ().Effects().
+		ForAccount(master).
+		Page(db2.PageQuery{Order: db2.OrderAscending, Limit: db2.MaxPageSize}).
+		Select(&effects)
+	ht.Require.NoError(err)
+	ht.Require.True(len(effects) > 1)
+
+	count := func(stream *testStream) int {
+		n := 0
+		for _, e := range stream.Events {
+			if e.Data.(resource.SubscriptionEvent).Subscription == tag {
+				n++
+			}
+		}
+		return n
+	}
+
+	query := url.Values{"subscribe": {"ledgers", tag}}
+	action := subscriptionsAction(ht, query, "")
+	stream := &testStream{}
+	action.SSE(stream)
+	ht.Require.NoError(action.Err)
+	ht.Assert.Equal(len(effects), count(stream))
+
+	// effect paging tokens are an operation id and order pair
+	last := stream.lastID(tag)
+	ht.Assert.Contains(last, "-")
+
+	// reconnecting after an effects event resumes the effects subscription
+	action = subscriptionsAction(ht, query, "1="+last)
+	stream = &testStream{}
+	action.SSE(stream)
+	ht.Require.NoError(action.Err)
+	ht.Assert.Equal(0, count(stream))
+
+	// as does providing an effect cursor in the subscription
+	query = url.Values{"subscribe": {tag + "@" + effects[0].PagingToken()}}
+	action = subscriptionsAction(ht, query, "")
+	stream = &testStream{}
+	action.SSE(stream)
+	ht.Require.NoError(action.Err)
+	ht.Assert.Equal(len(effects)-1, count(stream))
+}
+
+func TestParseSubscription(t *testing.T) {
+	tt := test.Start(t)
+	defer tt.Finish()
+
+	issuer := "GBRPYHIL2CI3FNQ4BXLFMNDLFJUNPU2HY3ZMFSHONUCEOASW7QC7OX2H"
+
+	sub, err := parseSubscription("payments/" + issuer + "@1234")
+	if tt.Assert.NoError(err) {
+		tt.Assert.Equal("payments/"+issuer, sub.Tag)
+		tt.Assert.Equal(subscriptionPayments, sub.Kind)
+		tt.Assert.Equal(issuer, sub.Account)
+		tt.Assert.Equal("1234", sub.Cursor)
+	}
+
+	sub, err = parseSubscription("order_book/native/EURT:" + issuer)
+	if tt.Assert.NoError(err) {
+		tt.Assert.Equal("native", sub.Selling.String())
+		tt.Assert.Equal("credit_alphanum4/EURT/"+issuer, sub.Buying.String())
+	}
+
+	sub, err = parseSubscription("effects/" + issuer + "@12884905985-2")
+	if tt.Assert.NoError(err) {
+		tt.Assert.Equal("12884905985-2", sub.Cursor)
+	}
+
+	invalid := []string{
+		"",
+		"ledgers/extra",
+		"ledgers@bogus",
+		"effects",
+		"effects/bogus",
+		"effects/" + issuer + "@12-bogus",
+		"payments/" + issuer + "@12-1",
+		"order_book/native",
+		"order_book/native/EURT",
+		"order_book/native/TOOLONGASSETCODE:" + issuer,
+		"order_book/native/native@1234",
+		"trades/" + issuer,
+	}
+	for _, value := range invalid {
+		_, err = parseSubscription(value)
+		tt.Assert.Error(err, value)
+	}
+
+	subs := []*subscription{
+		{Kind: subscriptionLedgers},
+		{Kind: subscriptionEffects},
+		{Kind: subscriptionPayments},
+		{Kind: subscriptionOrderBook},
+	}
+
+	i, cursor, err := parseSubscriptionCursor("2=34", subs)
+	if tt.Assert.NoError(err) {
+		tt.Assert.Equal(2, i)
+		tt.Assert.Equal("34", cursor)
+	}
+
+	i, cursor, err = parseSubscriptionCursor("1=12884905985-2", subs)
+	if tt.Assert.NoError(err) {
+		tt.Assert.Equal(1, i)
+		tt.Assert.Equal("12884905985-2", cursor)
+	}
+
+	_, _, err = parseSubscriptionCursor("4=12", subs)
+	tt.Assert.Error(err)
+	_, _, err = parseSubscriptionCursor("0=bogus", subs)
+	tt.Assert.Error(err)
+	_, _, err = parseSubscriptionCursor("0=12,2=34", subs)
+	tt.Assert.Error(err)
+	_, _, err = parseSubscriptionCursor("2=12-1", subs)
+	tt.Assert.Error(err)
+	_, _, err = parseSubscriptionCursor("3=12", subs)
+	tt.Assert.Error(err)
+}
+
+func subscriptionsAction(ht *HTTPT, query url.Values, lastEventID string) *SubscriptionsAction {
+	r, _ := http.NewRequest("GET", "/subscriptions?"+query.Encode(), nil)
+	if lastEventID != "" {
+		r.Header.Set("Last-Event-ID", lastEventID)
+	}
+
+	return &SubscriptionsAction{
+		Action: Action{
+			Base: actions.Base{Ctx: test.Context(), R: r},
+			App:  ht.App,
+		},
+	}
+}
+
+// testStream is an sse.Stream that records the events sent to it.
+type testStream struct {
+	Events []sse.Event
+	done   bool
+	limit  int
+}
+
+func (s *testStream) Send(e sse.Event) { s.Events = append(s.Events, e) }
+func (s *testStream) SentCount() int   { return len(s.Events) }
+func (s *testStream) Done()            { s.done = true }
+func (s *testStream) SetLimit(l int)   { s.limit = l }
+func (s *testStream) Err(error)        { s.done = true }
+
+func (s *testStream) IsDone() bool {
+	return s.done || (s.limit != 0 && len(s.Events) >= s.limit)
+}
+
+// lastID returns the paging token of the last record sent for `tag`.
+func (s *testStream) lastID(tag string) string {
+	var token string
+	for _, e := range s.Events {
+		se := e.Data.(resource.SubscriptionEvent)
+		if se.Subscription != tag {
+			continue
+		}
+
+		if p, ok := se.Record.(interface {
+			PagingToken() string
+		}); ok {
+			token = p.PagingToken()
+		}
+	}
+	return token
+}
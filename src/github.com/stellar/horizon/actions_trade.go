@@ -179,3 +179,97 @@ func (action *TradeEffectIndexAction) loadPage() {
 	action.Page.Order = action.PagingParams.Order
 	action.Page.PopulateLinks()
 }
+
+// TradeAggregateIndexAction renders the trades between a base and counter
+// asset, aggregated into buckets of a fixed resolution.
+type TradeAggregateIndexAction struct {
+	Action
+	BaseAssetFilter    xdr.Asset
+	CounterAssetFilter xdr.Asset
+	StartTimeFilter    int64
+	EndTimeFilter      int64
+	ResolutionFilter   int64
+	PagingParams       db2.PageQuery
+	Records            []history.TradeAggregation
+	Page               hal.Page
+}
+
+// JSON is a method for actions.JSON
+func (action *TradeAggregateIndexAction) JSON() {
+	action.Do(
+		action.EnsureHistoryFreshness,
+		action.loadParams,
+		action.loadRecords,
+		action.loadPage,
+		func() {
+			hal.Render(action.W, action.Page)
+		},
+	)
+}
+
+func (action *TradeAggregateIndexAction) loadParams() {
+	action.BaseAssetFilter = action.GetAsset("base_")
+	action.CounterAssetFilter = action.GetAsset("counter_")
+	action.StartTimeFilter = action.GetInt64("start_time")
+	action.EndTimeFilter = action.GetInt64("end_time")
+	action.ResolutionFilter = action.GetInt64("resolution")
+	action.PagingParams = action.GetPageQuery()
+
+	if action.Err != nil {
+		return
+	}
+
+	if action.ResolutionFilter <= 0 {
+		action.SetInvalidField("resolution", errors.New("must be a positive number of milliseconds"))
+		return
+	}
+
+	if action.StartTimeFilter < 0 {
+		action.SetInvalidField("start_time", errors.New("must not be negative"))
+		return
+	}
+
+	if action.EndTimeFilter < 0 {
+		action.SetInvalidField("end_time", errors.New("must not be negative"))
+		return
+	}
+
+	if action.EndTimeFilter > 0 && action.EndTimeFilter <= action.StartTimeFilter {
+		action.SetInvalidField("end_time", errors.New("must be after start_time"))
+		return
+	}
+}
+
+// loadRecords populates action.Records
+func (action *TradeAggregateIndexAction) loadRecords() {
+	action.Err = action.HistoryQ().
+		TradeAggregations(
+			action.BaseAssetFilter,
+			action.CounterAssetFilter,
+			action.ResolutionFilter,
+		).
+		ForTimeRange(action.StartTimeFilter, action.EndTimeFilter).
+		Page(action.PagingParams).
+		Select(&action.Records)
+}
+
+// loadPage populates action.Page
+func (action *TradeAggregateIndexAction) loadPage() {
+	for _, record := range action.Records {
+		var res resource.TradeAggregation
+
+		action.Err = res.Populate(action.Ctx, record)
+		if action.Err != nil {
+			return
+		}
+
+		action.Page.Add(res)
+	}
+
+	action.Page.BaseURL = action.BaseURL()
+	action.Page.BasePath = action.Path()
+	action.Page.Limit = action.PagingParams.Limit
+	action.Page.Cursor = action.PagingParams.Cursor
+	action.Page.Order = action.PagingParams.Order
+	action.Page.PopulateLinks()
+}
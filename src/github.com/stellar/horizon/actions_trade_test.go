@@ -102,3 +102,54 @@ func TestTradeActions_IndexRegressions(t *testing.T) {
 		ht.Assert.PageOf(0, w.Body)
 	}
 }
+
+func TestTradeActions_Aggregation(t *testing.T) {
+	ht := StartHTTPTest(t, "trades")
+	defer ht.Finish()
+
+	var q = make(url.Values)
+	q.Add("base_asset_type", "credit_alphanum4")
+	q.Add("base_asset_code", "EUR")
+	q.Add("base_asset_issuer", "GCQPYGH4K57XBDENKKX55KDTWOTK5WDWRQOH2LHEDX3EKVIQRLMESGBG")
+	q.Add("counter_asset_type", "credit_alphanum4")
+	q.Add("counter_asset_code", "USD")
+	q.Add("counter_asset_issuer", "GC23QF2HUE52AMXUFUH3AYJAXXGXXV2VHXYYR6EYXETPKDXZSAW67XO4")
+	q.Add("resolution", "60000")
+
+	w := ht.Get("/trade_aggregations?" + q.Encode())
+	if ht.Assert.Equal(200, w.Code) {
+		ht.Assert.PageOf(1, w.Body)
+
+		records := []resource.TradeAggregation{}
+		ht.UnmarshalPage(w.Body, &records)
+
+		l := history.Ledger{}
+		hq := history.Q{Session: ht.HorizonSession()}
+		ht.Require.NoError(hq.LedgerBySequence(&l, 6))
+		closedAt := l.ClosedAt.UnixNano() / int64(time.Millisecond)
+
+		ht.Assert.Equal(closedAt-closedAt%60000, records[0].Timestamp)
+		ht.Assert.Equal(int64(1), records[0].TradeCount)
+		ht.Assert.Equal("50.0000000", records[0].BaseVolume)
+		ht.Assert.Equal("50.0000000", records[0].CounterVolume)
+		ht.Assert.Equal("1.0000000", records[0].Open)
+		ht.Assert.Equal("1.0000000", records[0].Close)
+	}
+
+	// time range excluding the trade
+	q.Set("end_time", "1000")
+	w = ht.Get("/trade_aggregations?" + q.Encode())
+	if ht.Assert.Equal(200, w.Code) {
+		ht.Assert.PageOf(0, w.Body)
+	}
+	q.Del("end_time")
+
+	// invalid resolution
+	q.Set("resolution", "0")
+	w = ht.Get("/trade_aggregations?" + q.Encode())
+	ht.Assert.Equal(400, w.Code)
+
+	// missing assets
+	w = ht.Get("/trade_aggregations?resolution=60000")
+	ht.Assert.Equal(400, w.Code)
+}
@@ -1,6 +1,8 @@
 package horizon
 
 import (
+	"errors"
+	"fmt"
 	"net/http"
 
 	"github.com/stellar/horizon/db2"
@@ -10,12 +12,17 @@ import (
 	"github.com/stellar/horizon/render/sse"
 	"github.com/stellar/horizon/resource"
 	"github.com/stellar/horizon/txsub"
+	"golang.org/x/net/context"
 )
 
 // This file contains the actions:
 //
 // TransactionIndexAction: pages of transactions
 // TransactionShowAction: single transaction by sequence, by hash or id
+// TransactionCreateAction: submits a transaction to stellar-core
+// TransactionStatusAction: submission status of a single transaction by hash
+// TransactionValidateAction: checks a transaction without submitting it
+// TransactionBatchAction: submits several transactions to stellar-core
 
 // TransactionIndexAction renders a page of ledger resources, identified by
 // a normal page query.
@@ -23,6 +30,7 @@ type TransactionIndexAction struct {
 	Action
 	LedgerFilter  int32
 	AccountFilter string
+	IncludeFailed bool
 	PagingParams  db2.PageQuery
 	Records       []history.Transaction
 	Page          hal.Page
@@ -64,10 +72,16 @@ func (action *TransactionIndexAction) SSE(stream sse.Stream) {
 	)
 }
 
+// Topics is a method for actions.Watcher
+func (action *TransactionIndexAction) Topics() []string {
+	return accountTopics(action.GetString("account_id"))
+}
+
 func (action *TransactionIndexAction) loadParams() {
 	action.ValidateCursorAsDefault()
 	action.AccountFilter = action.GetString("account_id")
 	action.LedgerFilter = action.GetInt32("ledger_id")
+	action.IncludeFailed = action.GetBool("include_failed")
 	action.PagingParams = action.GetPageQuery()
 }
 
@@ -82,6 +96,10 @@ func (action *TransactionIndexAction) loadRecords() {
 		txs.ForLedger(action.LedgerFilter)
 	}
 
+	if action.IncludeFailed {
+		txs.IncludeFailed()
+	}
+
 	action.Err = txs.Page(action.PagingParams).Select(&action.Records)
 }
 
@@ -132,12 +150,18 @@ func (action *TransactionShowAction) JSON() {
 }
 
 // TransactionCreateAction submits a transaction to the stellar-core network
-// on behalf of the requesting client.
+// on behalf of the requesting client.  When Async is set, or when the client
+// sends a `Prefer: respond-async` header, the action responds as soon as
+// stellar-core accepts the transaction rather than waiting for it to be
+// included in a ledger.
 type TransactionCreateAction struct {
 	Action
-	TX       string
-	Result   txsub.Result
-	Resource resource.TransactionSuccess
+	Async            bool
+	TX               string
+	Result           txsub.Result
+	Accepted         bool
+	Resource         resource.TransactionSuccess
+	AcceptedResource resource.TransactionAccepted
 }
 
 // JSON format action handler
@@ -145,9 +169,18 @@ func (action *TransactionCreateAction) JSON() {
 	action.Do(
 		action.loadTX,
 		action.loadResult,
-		action.loadResource,
-
 		func() {
+			if action.Accepted {
+				action.AcceptedResource.Populate(action.Ctx, action.Result)
+				hal.RenderStatus(action.W, http.StatusAccepted, action.AcceptedResource)
+				return
+			}
+
+			action.loadResource()
+			if action.Err != nil {
+				return
+			}
+
 			hal.Render(action.W, action.Resource)
 		})
 }
@@ -155,9 +188,18 @@ func (action *TransactionCreateAction) JSON() {
 func (action *TransactionCreateAction) loadTX() {
 	action.ValidateBodyType()
 	action.TX = action.GetString("tx")
+
+	if action.R.Header.Get("Prefer") == "respond-async" {
+		action.Async = true
+	}
 }
 
 func (action *TransactionCreateAction) loadResult() {
+	if action.Async {
+		action.Result, action.Accepted = action.App.submitter.SubmitAsync(action.Ctx, action.TX)
+		return
+	}
+
 	submission := action.App.submitter.Submit(action.Ctx, action.TX)
 
 	select {
@@ -174,22 +216,26 @@ func (action *TransactionCreateAction) loadResource() {
 		return
 	}
 
-	if action.Result.Err == txsub.ErrTimeout {
-		action.Err = &problem.Timeout
-		return
+	action.Err = transactionProblem(action.Ctx, action.Result.EnvelopeXDR, action.Result.Err)
+}
+
+// transactionProblem converts `err`, the error that occurred submitting or
+// validating the transaction envelope `env`, into a problem where possible.
+func transactionProblem(ctx context.Context, env string, err error) error {
+	if err == txsub.ErrTimeout {
+		return &problem.Timeout
 	}
 
-	if action.Result.Err == txsub.ErrCanceled {
-		action.Err = &problem.Timeout
-		return
+	if err == txsub.ErrCanceled {
+		return &problem.Timeout
 	}
 
-	switch err := action.Result.Err.(type) {
+	switch err := err.(type) {
 	case *txsub.FailedTransactionError:
 		rcr := resource.TransactionResultCodes{}
-		rcr.Populate(action.Ctx, err)
+		rcr.Populate(ctx, err)
 
-		action.Err = &problem.P{
+		return &problem.P{
 			Type:   "transaction_failed",
 			Title:  "Transaction Failed",
 			Status: http.StatusBadRequest,
@@ -198,13 +244,33 @@ func (action *TransactionCreateAction) loadResource() {
 				"details.  Descriptions of each code can be found at: " +
 				"https://www.stellar.org/developers/learn/concepts/list-of-operations.html",
 			Extras: map[string]interface{}{
-				"envelope_xdr": action.Result.EnvelopeXDR,
+				"envelope_xdr": env,
 				"result_xdr":   err.ResultXDR,
 				"result_codes": rcr,
 			},
 		}
+	case *txsub.InvalidTransactionError:
+		rcr := resource.TransactionResultCodes{}
+		rcr.PopulateFromInvalid(ctx, err)
+
+		return &problem.P{
+			Type:   "transaction_invalid",
+			Title:  "Transaction Invalid",
+			Status: http.StatusBadRequest,
+			Detail: "The transaction would be rejected by the stellar network, so " +
+				"horizon did not submit it. The `extras.reason` field on this " +
+				"response explains why, and the `extras.result_codes` field " +
+				"contains the result codes stellar-core would have responded with. " +
+				"Descriptions of each code can be found at: " +
+				"https://www.stellar.org/developers/learn/concepts/list-of-operations.html",
+			Extras: map[string]interface{}{
+				"envelope_xdr": env,
+				"result_codes": rcr,
+				"reason":       err.Reason,
+			},
+		}
 	case *txsub.MalformedTransactionError:
-		action.Err = &problem.P{
+		return &problem.P{
 			Type:   "transaction_malformed",
 			Title:  "Transaction Malformed",
 			Status: http.StatusBadRequest,
@@ -218,6 +284,181 @@ func (action *TransactionCreateAction) loadResource() {
 			},
 		}
 	default:
-		action.Err = err
+		return err
+	}
+}
+
+// TransactionStatusAction reports how far the submission of a single
+// transaction, identified by its hash, has progressed.
+type TransactionStatusAction struct {
+	Action
+	Hash     string
+	Status   txsub.TransactionStatus
+	Result   txsub.Result
+	Resource resource.TransactionStatus
+}
+
+// JSON is a method for actions.JSON
+func (action *TransactionStatusAction) JSON() {
+	action.Do(
+		action.loadParams,
+		action.loadStatus,
+		action.loadResource,
+		func() {
+			hal.Render(action.W, action.Resource)
+		},
+	)
+}
+
+func (action *TransactionStatusAction) loadParams() {
+	action.Hash = action.GetString("id")
+}
+
+func (action *TransactionStatusAction) loadStatus() {
+	action.Status, action.Result, action.Err = action.App.submitter.Status(action.Ctx, action.Hash)
+	if action.Err != nil {
+		return
+	}
+
+	if action.Status == txsub.TransactionStatusUnknown {
+		action.Err = &problem.NotFound
+	}
+}
+
+func (action *TransactionStatusAction) loadResource() {
+	action.Err = action.Resource.Populate(action.Ctx, action.Hash, action.Status, action.Result)
+}
+
+// TransactionValidateAction checks a transaction as it would be checked before
+// submission, without submitting it.
+type TransactionValidateAction struct {
+	Action
+	TX       string
+	Hash     string
+	Resource resource.TransactionValidation
+}
+
+// JSON format action handler
+func (action *TransactionValidateAction) JSON() {
+	action.Do(
+		action.loadTX,
+		action.validate,
+		func() {
+			action.Resource.Populate(action.Ctx, action.Hash, action.TX)
+			hal.Render(action.W, action.Resource)
+		},
+	)
+}
+
+func (action *TransactionValidateAction) loadTX() {
+	action.ValidateBodyType()
+	action.TX = action.GetString("tx")
+}
+
+func (action *TransactionValidateAction) validate() {
+	var err error
+	action.Hash, err = action.App.submitter.Validate(action.Ctx, action.TX)
+	if err != nil {
+		action.Err = transactionProblem(action.Ctx, action.TX, err)
+	}
+}
+
+// MaxTransactionBatchSize is the largest number of transactions that can be
+// submitted in a single batch.
+const MaxTransactionBatchSize = 100
+
+// TransactionBatchAction submits several independent transactions to the
+// stellar-core network concurrently, on behalf of the requesting client.
+// Transactions from the same source account are submitted in sequence number
+// order by the submission system's sequence manager.
+type TransactionBatchAction struct {
+	Action
+	TXs     []string
+	Results chan resource.TransactionBatchResult
+	Page    hal.BasePage
+}
+
+// JSON format action handler
+func (action *TransactionBatchAction) JSON() {
+	action.Do(
+		action.loadTXs,
+		action.submit,
+		func() {
+			records := make([]resource.TransactionBatchResult, len(action.TXs))
+			for range action.TXs {
+				res := <-action.Results
+				records[res.Index] = res
+			}
+
+			action.Page.Init()
+			for _, record := range records {
+				action.Page.Add(record)
+			}
+
+			hal.Render(action.W, action.Page)
+		},
+	)
+}
+
+// SSE is a method for actions.SSE.  Each transaction's result is sent as soon
+// as it is known.
+func (action *TransactionBatchAction) SSE(stream sse.Stream) {
+	action.Setup(
+		action.loadTXs,
+		action.submit,
+	)
+	action.Do(func() {
+		stream.SetLimit(len(action.TXs))
+
+		for stream.SentCount() < len(action.TXs) {
+			select {
+			case res := <-action.Results:
+				stream.Send(sse.Event{ID: res.PagingToken(), Data: res})
+			case <-action.Ctx.Done():
+				return
+			}
+		}
+	})
+}
+
+func (action *TransactionBatchAction) loadTXs() {
+	action.ValidateBodyType()
+	action.TXs = action.GetStrings("tx")
+	if action.Err != nil {
+		return
+	}
+
+	switch {
+	case len(action.TXs) == 0:
+		action.SetInvalidField("tx", errors.New("at least one transaction is required"))
+	case len(action.TXs) > MaxTransactionBatchSize:
+		action.SetInvalidField("tx", fmt.Errorf("at most %d transactions may be submitted in a batch", MaxTransactionBatchSize))
+	}
+}
+
+// submit starts the submission of every transaction, sending the result of
+// each on action.Results once it is known.
+func (action *TransactionBatchAction) submit() {
+	action.Results = make(chan resource.TransactionBatchResult, len(action.TXs))
+
+	for i, tx := range action.TXs {
+		go func(i int, tx string) {
+			var result txsub.Result
+
+			select {
+			case result = <-action.App.submitter.Submit(action.Ctx, tx):
+			case <-action.Ctx.Done():
+				result = txsub.Result{Err: txsub.ErrCanceled, EnvelopeXDR: tx}
+			}
+
+			var res resource.TransactionBatchResult
+			if result.Err == nil {
+				res.Populate(action.Ctx, i, result)
+			} else {
+				res.PopulateProblem(action.Ctx, i, transactionProblem(action.Ctx, tx, result.Err))
+			}
+
+			action.Results <- res
+		}(i, tx)
 	}
 }
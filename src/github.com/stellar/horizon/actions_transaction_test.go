@@ -2,6 +2,7 @@ package horizon
 
 import (
 	"encoding/json"
+	"net/http"
 	"net/url"
 	"testing"
 
@@ -106,3 +107,167 @@ func TestTransactionActions_Post(t *testing.T) {
 	w = ht.Post("/transactions", form)
 	ht.Assert.Equal(503, w.Code)
 }
+
+func TestTransactionActions_PostAsync(t *testing.T) {
+	ht := StartHTTPTest(t, "base")
+	defer ht.Finish()
+
+	hash := "2374e99349b9ef7dba9a5db3339b78fda8f34777b1af33ba468ad5c0df946d4d"
+	form := url.Values{"tx": []string{"AAAAAGL8HQvQkbK2HA3WVjRrKmjX00fG8sLI7m0ERwJW/AX3AAAAZAAAAAAAAAABAAAAAAAAAAAAAAABAAAAAAAAAAAAAAAArqN6LeOagjxMaUP96Bzfs9e0corNZXzBWJkFoK7kvkwAAAAAO5rKAAAAAAAAAAABVvwF9wAAAECDzqvkQBQoNAJifPRXDoLhvtycT3lFPCQ51gkdsFHaBNWw05S/VhW0Xgkr0CBPE4NaFV2Kmcs3ZwLmib4TRrML"}}
+
+	// existing transaction
+	w := ht.Post("/transactions_async", form)
+	ht.Assert.Equal(200, w.Code)
+
+	// newly accepted transaction
+	ht.App.submitter.Results = &txsub.MockResultProvider{}
+	ht.App.submitter.Submitter = &txsub.MockSubmitter{}
+	ht.App.submitter.Validator = nil
+	ht.App.submitter.Sequences = &txsub.MockSequenceProvider{
+		Results: map[string]uint64{
+			"GBRPYHIL2CI3FNQ4BXLFMNDLFJUNPU2HY3ZMFSHONUCEOASW7QC7OX2H": 0,
+		},
+	}
+
+	w = ht.Post("/transactions_async", form)
+	if ht.Assert.Equal(202, w.Code) {
+		var result resource.TransactionAccepted
+		err := json.Unmarshal(w.Body.Bytes(), &result)
+		ht.Require.NoError(err)
+		ht.Assert.Equal(hash, result.Hash)
+		ht.Assert.Equal("pending", result.Status)
+	}
+
+	// the Prefer header triggers async submission on the regular endpoint
+	ht.App.submitter.Pending = txsub.NewDefaultSubmissionList()
+	w = ht.Post("/transactions", form, func(r *http.Request) {
+		r.Header.Set("Prefer", "respond-async")
+	})
+	ht.Assert.Equal(202, w.Code)
+}
+
+func TestTransactionActions_Status(t *testing.T) {
+	ht := StartHTTPTest(t, "base")
+	defer ht.Finish()
+
+	hash := "2374e99349b9ef7dba9a5db3339b78fda8f34777b1af33ba468ad5c0df946d4d"
+
+	// existing transaction
+	w := ht.Get("/transactions/" + hash + "/status")
+	if ht.Assert.Equal(200, w.Code) {
+		var result resource.TransactionStatus
+		err := json.Unmarshal(w.Body.Bytes(), &result)
+		ht.Require.NoError(err)
+		ht.Assert.Equal(hash, result.Hash)
+		ht.Assert.Equal("success", result.Status)
+		ht.Assert.NotEqual(int32(0), result.Ledger)
+	}
+
+	// pending transaction
+	ht.App.submitter.Results = &txsub.MockResultProvider{}
+	ht.App.submitter.Pending.Add(ht.Ctx, hash, make(chan txsub.Result, 1))
+	w = ht.Get("/transactions/" + hash + "/status")
+	if ht.Assert.Equal(200, w.Code) {
+		var result resource.TransactionStatus
+		err := json.Unmarshal(w.Body.Bytes(), &result)
+		ht.Require.NoError(err)
+		ht.Assert.Equal("pending", result.Status)
+	}
+
+	// unknown transaction
+	w = ht.Get("/transactions/not_real/status")
+	ht.Assert.Equal(404, w.Code)
+}
+
+func TestTransactionActions_Validate(t *testing.T) {
+	ht := StartHTTPTest(t, "base")
+	defer ht.Finish()
+
+	// already applied, so the sequence number has been used
+	form := url.Values{"tx": []string{"AAAAAGL8HQvQkbK2HA3WVjRrKmjX00fG8sLI7m0ERwJW/AX3AAAAZAAAAAAAAAABAAAAAAAAAAAAAAABAAAAAAAAAAAAAAAArqN6LeOagjxMaUP96Bzfs9e0corNZXzBWJkFoK7kvkwAAAAAO5rKAAAAAAAAAAABVvwF9wAAAECDzqvkQBQoNAJifPRXDoLhvtycT3lFPCQ51gkdsFHaBNWw05S/VhW0Xgkr0CBPE4NaFV2Kmcs3ZwLmib4TRrML"}}
+	w := ht.Post("/transactions/validate", form)
+	if ht.Assert.Equal(400, w.Code) {
+		ht.Assert.ProblemType(w.Body, "transaction_invalid")
+	}
+
+	// valid
+	ht.App.submitter.Validator = &txsub.MockValidator{}
+	w = ht.Post("/transactions/validate", form)
+	if ht.Assert.Equal(200, w.Code) {
+		var result resource.TransactionValidation
+		err := json.Unmarshal(w.Body.Bytes(), &result)
+		ht.Require.NoError(err)
+		ht.Assert.Equal("2374e99349b9ef7dba9a5db3339b78fda8f34777b1af33ba468ad5c0df946d4d", result.Hash)
+		ht.Assert.True(result.Valid)
+	}
+
+	// malformed
+	w = ht.Post("/transactions/validate", url.Values{"tx": []string{"not an envelope"}})
+	if ht.Assert.Equal(400, w.Code) {
+		ht.Assert.ProblemType(w.Body, "transaction_malformed")
+	}
+}
+
+func TestTransactionActions_Batch(t *testing.T) {
+	ht := StartHTTPTest(t, "base")
+	defer ht.Finish()
+
+	form := url.Values{"tx": []string{
+		"AAAAAGL8HQvQkbK2HA3WVjRrKmjX00fG8sLI7m0ERwJW/AX3AAAAZAAAAAAAAAABAAAAAAAAAAAAAAABAAAAAAAAAAAAAAAArqN6LeOagjxMaUP96Bzfs9e0corNZXzBWJkFoK7kvkwAAAAAO5rKAAAAAAAAAAABVvwF9wAAAECDzqvkQBQoNAJifPRXDoLhvtycT3lFPCQ51gkdsFHaBNWw05S/VhW0Xgkr0CBPE4NaFV2Kmcs3ZwLmib4TRrML",
+		"not an envelope",
+	}}
+
+	w := ht.Post("/transactions/batch", form)
+	if ht.Assert.Equal(200, w.Code) {
+		var page struct {
+			Embedded struct {
+				Records []resource.TransactionBatchResult `json:"records"`
+			} `json:"_embedded"`
+		}
+		err := json.Unmarshal(w.Body.Bytes(), &page)
+		ht.Require.NoError(err)
+
+		records := page.Embedded.Records
+		if ht.Assert.Len(records, 2) {
+			ht.Assert.Equal(0, records[0].Index)
+			ht.Assert.Equal("success", records[0].Status)
+			ht.Assert.Equal(
+				"2374e99349b9ef7dba9a5db3339b78fda8f34777b1af33ba468ad5c0df946d4d",
+				records[0].Transaction.Hash,
+			)
+			ht.Assert.Nil(records[0].Problem)
+
+			ht.Assert.Equal(1, records[1].Index)
+			ht.Assert.Equal("failed", records[1].Status)
+			ht.Assert.Nil(records[1].Transaction)
+			ht.Assert.Contains(records[1].Problem.Type, "transaction_malformed")
+		}
+	}
+
+	// streams each result
+	w = ht.Post("/transactions/batch", form, func(r *http.Request) {
+		r.Header.Set("Accept", "text/event-stream")
+	})
+	ht.Assert.Equal(200, w.Code)
+	ht.Assert.Contains(w.Body.String(), "transaction_malformed")
+	ht.Assert.Contains(w.Body.String(), "id: 0")
+	ht.Assert.Contains(w.Body.String(), "id: 1")
+
+	// no transactions
+	w = ht.Post("/transactions/batch", url.Values{})
+	ht.Assert.Equal(400, w.Code)
+}
+
+func TestTransactionActions_BatchFromOneAccount(t *testing.T) {
+	ht := StartHTTPTest(t, "base")
+	defer ht.Finish()
+
+	// with the default limits, the submission queue holds a full batch of
+	// transactions from a single source account
+	queue := ht.App.submitter.SubmissionQueue
+	for i := 0; i < MaxTransactionBatchSize; i++ {
+		errs := queue.Push("GBRPYHIL2CI3FNQ4BXLFMNDLFJUNPU2HY3ZMFSHONUCEOASW7QC7OX2H", uint64(i+2))
+		ht.Require.Len(errs, 0)
+	}
+	ht.Assert.Equal(MaxTransactionBatchSize, queue.Size())
+}
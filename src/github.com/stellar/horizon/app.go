@@ -10,6 +10,7 @@ import (
 	"time"
 
 	"github.com/garyburd/redigo/redis"
+	"github.com/lib/pq"
 	metrics "github.com/rcrowley/go-metrics"
 	"github.com/stellar/go/build"
 	"github.com/stellar/go/support/db"
@@ -19,6 +20,7 @@ import (
 	"github.com/stellar/horizon/ingest"
 	"github.com/stellar/horizon/ledger"
 	"github.com/stellar/horizon/log"
+	"github.com/stellar/horizon/memorypath"
 	"github.com/stellar/horizon/paths"
 	"github.com/stellar/horizon/reap"
 	"github.com/stellar/horizon/render/sse"
@@ -46,10 +48,12 @@ type App struct {
 	protocolVersion   int32
 	submitter         *txsub.System
 	paths             paths.Finder
+	pathGraph         *memorypath.Graph
 	friendbot         *friendbot.Bot
 	ingester          *ingest.System
 	reaper            *reap.System
 	ticks             *time.Ticker
+	ledgerCloses      *pq.Listener
 
 	// metrics
 	metrics                  metrics.Registry
@@ -127,6 +131,14 @@ func (a *App) Close() {
 	a.cancel()
 	a.ticks.Stop()
 
+	if a.ledgerCloses != nil {
+		a.ledgerCloses.Close()
+	}
+
+	if a.ingester != nil && a.ingester.Election != nil {
+		a.ingester.Election.Resign()
+	}
+
 	a.historyQ.Session.DB.Close()
 	a.coreQ.Session.DB.Close()
 }
@@ -279,12 +291,16 @@ func (a *App) Tick() {
 	wg.Wait()
 
 	if a.ingester != nil {
-		go a.ingester.Tick()
+		go a.ingest()
 	}
 
 	wg.Add(2)
 	go func() { a.reaper.Tick(); wg.Done() }()
 	go func() { a.submitter.Tick(a.ctx); wg.Done() }()
+	if a.pathGraph != nil {
+		wg.Add(1)
+		go func() { a.pathGraph.Tick(); wg.Done() }()
+	}
 	wg.Wait()
 
 	sse.Tick()
@@ -294,6 +310,26 @@ func (a *App) Tick() {
 	log.Debug("finished ticking app")
 }
 
+// ingest runs an ingestion session.  Once it has ingested new ledgers, the
+// ledger state is refreshed and the streams are pumped, so that they send the
+// new ledgers without waiting for the next tick.
+func (a *App) ingest() {
+	is := a.ingester.Tick()
+	if is == nil || is.Err != nil || is.Ingested == 0 {
+		return
+	}
+
+	a.UpdateLedgerState()
+	sse.Tick()
+}
+
+// historyBehind returns true if the history database has not yet caught up
+// with the latest ledger closed by stellar-core.
+func (a *App) historyBehind() bool {
+	ls := ledger.CurrentState()
+	return ls.HistoryLatest < ls.CoreLatest
+}
+
 // Init initializes app, using the config to populate db connections and
 // whatnot.
 func (a *App) init() {
@@ -301,15 +337,51 @@ func (a *App) init() {
 }
 
 // run is the function that runs in the background that triggers Tick each
-// second
+// second or, when ledger close notifications are enabled, whenever
+// stellar-core closes a ledger and on each heartbeat.
+//
+// A ledger close is announced before the ledger is ingested, and a server that
+// does not ingest cannot tell when another server has.  While notifications
+// are enabled and the history database lags stellar-core, the app therefore
+// keeps ticking each second until it catches up.
 func (a *App) run() {
+	// when ledger close notifications are disabled, `closes` remains nil and
+	// the app is driven solely by the ticker.
+	var closes <-chan *pq.Notification
+	if a.ledgerCloses != nil {
+		closes = a.ledgerCloses.Notify
+	}
+
 	for {
+		var catchup <-chan time.Time
+		if closes != nil && a.historyBehind() {
+			catchup = time.After(time.Second)
+		}
+
 		select {
 		case <-a.ticks.C:
 			a.Tick()
+		case <-catchup:
+			a.Tick()
+		case <-closes:
+			// a single tick catches up with every ledger closed so far
+			drainNotifications(closes)
+			a.Tick()
 		case <-a.ctx.Done():
 			log.Info("finished background ticker")
 			return
 		}
 	}
 }
+
+// drainNotifications discards any notifications that are already waiting on
+// `c`.
+func drainNotifications(c <-chan *pq.Notification) {
+	for {
+		select {
+		case <-c:
+		default:
+			return
+		}
+	}
+}
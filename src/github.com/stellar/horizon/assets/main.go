@@ -2,6 +2,9 @@
 package assets
 
 import (
+	"fmt"
+	"strings"
+
 	"github.com/go-errors/errors"
 	"github.com/stellar/go/xdr"
 )
@@ -52,6 +55,33 @@ func MustString(aType xdr.AssetType) string {
 	return s
 }
 
+// FromString parses an asset written as either `native` or `<code>:<issuer>`.
+func FromString(value string) (result xdr.Asset, err error) {
+	if value == "native" {
+		err = result.SetNative()
+		return
+	}
+
+	parts := strings.Split(value, ":")
+	if len(parts) != 2 {
+		err = fmt.Errorf("invalid asset %s", value)
+		return
+	}
+
+	var issuer xdr.AccountId
+	err = issuer.SetAddress(parts[1])
+	if err != nil {
+		err = fmt.Errorf("invalid asset issuer %s", parts[1])
+		return
+	}
+
+	err = result.SetCredit(parts[0], issuer)
+	if err != nil {
+		err = fmt.Errorf("invalid asset code %s", parts[0])
+	}
+	return
+}
+
 // Equals returns true if l and r are equivalent.
 func Equals(l, r xdr.Asset) bool {
 	var le, re struct {
@@ -55,4 +55,25 @@ func TestAssets(t *testing.T) {
 		_, err = String(xdr.AssetType(15))
 		So(errors.Is(err, ErrInvalidValue), ShouldBeTrue)
 	})
+
+	Convey("FromString", t, func() {
+		issuer := "GBRPYHIL2CI3FNQ4BXLFMNDLFJUNPU2HY3ZMFSHONUCEOASW7QC7OX2H"
+
+		result, err := FromString("native")
+		So(err, ShouldBeNil)
+		So(result.Type, ShouldEqual, xdr.AssetTypeAssetTypeNative)
+
+		result, err = FromString("USD:" + issuer)
+		So(err, ShouldBeNil)
+		So(result.String(), ShouldEqual, "credit_alphanum4/USD/"+issuer)
+
+		result, err = FromString("LONGERCODE:" + issuer)
+		So(err, ShouldBeNil)
+		So(result.Type, ShouldEqual, xdr.AssetTypeAssetTypeCreditAlphanum12)
+
+		for _, value := range []string{"", "USD", "USD:bogus", "TOOLONGASSETCODE:" + issuer, "USD:" + issuer + ":extra"} {
+			_, err = FromString(value)
+			So(err, ShouldNotBeNil)
+		}
+	})
 }
@@ -0,0 +1,89 @@
+// Package bus provides the in-process event bus through which the ingestion
+// system announces newly ingested ledgers.  Streaming requests subscribe to
+// the topics they are watching so that they only re-query the database when a
+// ledger actually touches them.
+package bus
+
+import (
+	"sync"
+
+	"github.com/stellar/horizon/db2/history"
+)
+
+const (
+	// TopicLedgers is published for every ingested ledger.
+	TopicLedgers = "ledgers"
+
+	// MaxPending is the number of events a subscription buffers between calls
+	// to Drain.  Events published to a full subscription are dropped and the
+	// subscription is marked as having fallen behind.
+	MaxPending = 100
+)
+
+// AccountTopic returns the topic published for every ledger in which the
+// account identified by `address` participated.
+func AccountTopic(address string) string {
+	return "accounts/" + address
+}
+
+// Event describes the data ingested for a single ledger.
+type Event struct {
+	// Ledger is the ledger's row in the history database.
+	Ledger history.Ledger
+
+	// Accounts are the addresses of the accounts that participated in the
+	// ledger's transactions, and of the accounts whose trustlines, offers or
+	// data entries they changed.
+	Accounts []string
+}
+
+// Topics returns the topics `e` is published to.
+func (e Event) Topics() []string {
+	topics := make([]string, 0, len(e.Accounts)+1)
+	topics = append(topics, TopicLedgers)
+	for _, address := range e.Accounts {
+		topics = append(topics, AccountTopic(address))
+	}
+	return topics
+}
+
+// Subscription receives the events published to any of its topics.
+type Subscription struct {
+	topics []string
+	notify chan struct{}
+
+	lock    sync.Mutex
+	pending []Event
+	behind  bool
+}
+
+// Enable marks the bus as active, meaning that a component of this process
+// publishes an event for every ingested ledger.
+func Enable() {
+	EnableWhen(nil)
+}
+
+// EnableWhen marks the bus as active while `publishing` returns true.  It is
+// used when a component of this process only publishes some of the time, such
+// as an ingestion system that only ingests while it is the elected leader.
+func EnableWhen(publishing func() bool) {
+	lock.Lock()
+	enabled = true
+	publisher = publishing
+	lock.Unlock()
+}
+
+// Enabled returns true if events are being published to the bus.  When false,
+// subscribers should fall back to polling.
+func Enabled() bool {
+	lock.RLock()
+	ret := enabled
+	fn := publisher
+	lock.RUnlock()
+	return ret && (fn == nil || fn())
+}
+
+var enabled bool
+var publisher func() bool
+var lock sync.RWMutex
+var subscriptions = map[string]map[*Subscription]struct{}{}
@@ -0,0 +1,86 @@
+package bus
+
+import (
+	"testing"
+
+	"github.com/stellar/horizon/db2/history"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBus(t *testing.T) {
+	alice := "GA5WBPYA5Y4WAEHXWR2UKO2UO4BUGHUQ74EUPKON2QHV4WRHOIRNKKH2"
+	bob := "GCXKG6RN4ONIEPCMNFB732A436Z5PNDSRLGWK7GBLCMQLIFO4S7EYWVU"
+
+	ledgers := Subscribe(TopicLedgers)
+	defer ledgers.Close()
+	aliceSub := Subscribe(AccountTopic(alice))
+	defer aliceSub.Close()
+	bothSub := Subscribe(AccountTopic(alice), AccountTopic(bob))
+	defer bothSub.Close()
+
+	Publish(Event{Ledger: history.Ledger{Sequence: 2}, Accounts: []string{bob}})
+	Publish(Event{Ledger: history.Ledger{Sequence: 3}, Accounts: []string{alice, bob}})
+
+	// every ledger is published to the ledgers topic
+	<-ledgers.Notify()
+	events, complete := ledgers.Drain()
+	assert.True(t, complete)
+	if assert.Len(t, events, 2) {
+		assert.Equal(t, int32(2), events[0].Ledger.Sequence)
+		assert.Equal(t, int32(3), events[1].Ledger.Sequence)
+	}
+
+	// account subscriptions only see ledgers the account participated in
+	<-aliceSub.Notify()
+	events, _ = aliceSub.Drain()
+	if assert.Len(t, events, 1) {
+		assert.Equal(t, int32(3), events[0].Ledger.Sequence)
+	}
+
+	// events matching several topics are delivered once
+	<-bothSub.Notify()
+	events, _ = bothSub.Drain()
+	assert.Len(t, events, 2)
+
+	// draining empties the subscription
+	events, complete = ledgers.Drain()
+	assert.Len(t, events, 0)
+	assert.True(t, complete)
+
+	// closed subscriptions receive nothing
+	aliceSub.Close()
+	Publish(Event{Ledger: history.Ledger{Sequence: 4}, Accounts: []string{alice}})
+	events, _ = aliceSub.Drain()
+	assert.Len(t, events, 0)
+	ledgers.Drain()
+
+	// slow subscribers are marked as behind
+	for i := 0; i < MaxPending+1; i++ {
+		Publish(Event{Ledger: history.Ledger{Sequence: int32(5 + i)}})
+	}
+	events, complete = ledgers.Drain()
+	assert.Len(t, events, MaxPending)
+	assert.False(t, complete)
+}
+
+func TestEnableWhen(t *testing.T) {
+	defer func() {
+		lock.Lock()
+		enabled = false
+		publisher = nil
+		lock.Unlock()
+	}()
+
+	assert.False(t, Enabled())
+
+	publishing := false
+	EnableWhen(func() bool { return publishing })
+	assert.False(t, Enabled())
+
+	publishing = true
+	assert.True(t, Enabled())
+
+	Enable()
+	publishing = false
+	assert.True(t, Enabled())
+}
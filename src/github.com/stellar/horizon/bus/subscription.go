@@ -0,0 +1,88 @@
+package bus
+
+// Publish delivers `e` to every subscription watching at least one of the
+// event's topics.  Publish never blocks on slow subscribers.
+func Publish(e Event) {
+	lock.RLock()
+	defer lock.RUnlock()
+
+	delivered := map[*Subscription]struct{}{}
+	for _, topic := range e.Topics() {
+		for sub := range subscriptions[topic] {
+			if _, ok := delivered[sub]; ok {
+				continue
+			}
+
+			delivered[sub] = struct{}{}
+			sub.deliver(e)
+		}
+	}
+}
+
+// Subscribe creates a new subscription that receives the events published to
+// any of `topics`.  Callers must Close the subscription when done with it.
+func Subscribe(topics ...string) *Subscription {
+	sub := &Subscription{
+		topics: topics,
+		notify: make(chan struct{}, 1),
+	}
+
+	lock.Lock()
+	for _, topic := range topics {
+		subs, ok := subscriptions[topic]
+		if !ok {
+			subs = map[*Subscription]struct{}{}
+			subscriptions[topic] = subs
+		}
+		subs[sub] = struct{}{}
+	}
+	lock.Unlock()
+
+	return sub
+}
+
+// Close removes the subscription from the bus.
+func (sub *Subscription) Close() {
+	lock.Lock()
+	for _, topic := range sub.topics {
+		delete(subscriptions[topic], sub)
+		if len(subscriptions[topic]) == 0 {
+			delete(subscriptions, topic)
+		}
+	}
+	lock.Unlock()
+}
+
+// Drain returns the events received since the previous call, in the order
+// they were published.  `complete` is false if events were dropped because
+// the subscriber fell behind, in which case the caller should reload its data
+// from the database rather than rely on the returned events.
+func (sub *Subscription) Drain() (events []Event, complete bool) {
+	sub.lock.Lock()
+	events, complete = sub.pending, !sub.behind
+	sub.pending = nil
+	sub.behind = false
+	sub.lock.Unlock()
+	return
+}
+
+// Notify returns a channel that receives a value whenever new events are
+// available to Drain.
+func (sub *Subscription) Notify() <-chan struct{} {
+	return sub.notify
+}
+
+func (sub *Subscription) deliver(e Event) {
+	sub.lock.Lock()
+	if len(sub.pending) < MaxPending {
+		sub.pending = append(sub.pending, e)
+	} else {
+		sub.behind = true
+	}
+	sub.lock.Unlock()
+
+	select {
+	case sub.notify <- struct{}{}:
+	default:
+	}
+}
@@ -2,6 +2,8 @@ package main
 
 import (
 	"database/sql"
+	"encoding/json"
+	"errors"
 	"fmt"
 	"log"
 	"os"
@@ -11,6 +13,7 @@ import (
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
 	"github.com/stellar/go/support/db"
+	"github.com/stellar/horizon/db2/history"
 	"github.com/stellar/horizon/db2/schema"
 	"github.com/stellar/horizon/ingest"
 	hlog "github.com/stellar/horizon/log"
@@ -53,6 +56,12 @@ var dbInitCmd = &cobra.Command{
 			hlog.Error(err)
 			os.Exit(1)
 		}
+
+		err = migrateProcessors(db.DB.DB, schema.MigrateUp, 0)
+		if err != nil {
+			hlog.Error(err)
+			os.Exit(1)
+		}
 	},
 }
 
@@ -88,10 +97,26 @@ var dbMigrateCmd = &cobra.Command{
 			log.Fatal(err)
 		}
 
+		// processor tables may reference horizon's own, so they are migrated
+		// after horizon's tables on the way up and before them on the way down.
+		if dir == schema.MigrateDown {
+			err = migrateProcessors(db, dir, count)
+			if err != nil {
+				log.Fatal(err)
+			}
+		}
+
 		_, err = schema.Migrate(db, dir, count)
 		if err != nil {
 			log.Fatal(err)
 		}
+
+		if dir != schema.MigrateDown {
+			err = migrateProcessors(db, dir, count)
+			if err != nil {
+				log.Fatal(err)
+			}
+		}
 	},
 }
 
@@ -112,7 +137,10 @@ var dbReapCmd = &cobra.Command{
 var dbReingestCmd = &cobra.Command{
 	Use:   "reingest",
 	Short: "imports all data",
-	Long:  "reingest runs the ingestion pipeline over every ledger",
+	Long: "reingest runs the ingestion pipeline over every ledger.  When any of " +
+		"--parallel, --from or --to is provided, the range is split into chunks " +
+		"that are reingested concurrently, and an interrupted run resumes from " +
+		"the chunks it already completed.",
 	Run: func(cmd *cobra.Command, args []string) {
 		initConfig()
 		hlog.DefaultLogger.Logger.Level = config.LogLevel
@@ -136,7 +164,7 @@ var dbReingestCmd = &cobra.Command{
 
 		// run ingestion in separate goroutine
 		go func() {
-			_, err := reingest(i, args)
+			_, err := reingest(i, cmd, args)
 			done <- err
 			logStatus("complete")
 		}()
@@ -158,12 +186,116 @@ var dbReingestCmd = &cobra.Command{
 	},
 }
 
+var dbVerifyCmd = &cobra.Command{
+	Use:   "verify",
+	Short: "compares ingested history with stellar-core",
+	Long: "verify compares the history of a range of ledgers with stellar-core, " +
+		"including the effects and trades derived from each ledger, and prints " +
+		"a JSON report of the mismatches found.  With --fix, the ledgers with " +
+		"mismatches are reingested.  It exits with status 1 when mismatches " +
+		"remain.",
+	Run: func(cmd *cobra.Command, args []string) {
+		initConfig()
+		hlog.DefaultLogger.Logger.Level = config.LogLevel
+
+		i := ingestSystem()
+		i.SkipCursorUpdate = true
+
+		result, err := verify(i, cmd)
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+		err = enc.Encode(result)
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		if len(result.Ledgers) > len(result.Fixed) {
+			os.Exit(1)
+		}
+	},
+}
+
+// verifyResult is the report printed by `horizon db verify`.
+type verifyResult struct {
+	*ingest.VerifyReport
+
+	// Tables counts the mismatches found in each table.
+	Tables map[string]int `json:"tables"`
+
+	// Ledgers lists the ledgers with mismatches.
+	Ledgers []int32 `json:"ledgers"`
+
+	// Fixed lists the ledgers reingested by --fix.
+	Fixed []int32 `json:"fixed"`
+}
+
 func init() {
 	dbCmd.AddCommand(dbInitCmd)
 	dbCmd.AddCommand(dbClearCmd)
 	dbCmd.AddCommand(dbMigrateCmd)
 	dbCmd.AddCommand(dbReapCmd)
 	dbCmd.AddCommand(dbReingestCmd)
+	dbCmd.AddCommand(dbVerifyCmd)
+
+	dbReingestCmd.Flags().Int(
+		"parallel",
+		1,
+		"number of ledger chunks to reingest concurrently",
+	)
+
+	dbReingestCmd.Flags().Int(
+		"from",
+		0,
+		"first ledger to reingest (defaults to the oldest ledger in stellar-core)",
+	)
+
+	dbReingestCmd.Flags().Int(
+		"to",
+		0,
+		"last ledger to reingest (defaults to the latest ledger in stellar-core)",
+	)
+
+	dbVerifyCmd.Flags().Int(
+		"from",
+		0,
+		"first ledger to verify (defaults to the oldest ledger in horizon's history)",
+	)
+
+	dbVerifyCmd.Flags().Int(
+		"to",
+		0,
+		"last ledger to verify (defaults to the latest ledger in horizon's history)",
+	)
+
+	dbVerifyCmd.Flags().Bool(
+		"fix",
+		false,
+		"reingest the ledgers with mismatches",
+	)
+}
+
+// migrateProcessors runs the migrations of every registered ingestion
+// processor, recording each processor's applied migrations in a table of its
+// own.
+func migrateProcessors(db *sql.DB, dir schema.MigrateDir, count int) error {
+	for _, p := range ingest.Processors() {
+		source := p.Migrations()
+		if source == nil {
+			continue
+		}
+
+		table := fmt.Sprintf("%s_%s", schema.DefaultMigrationTable, p.Name())
+		_, err := schema.MigrateSource(db, source, table, dir, count)
+		if err != nil {
+			return fmt.Errorf("failed to migrate processor %s: %s", p.Name(), err)
+		}
+	}
+
+	return nil
 }
 
 func ingestSystem() *ingest.System {
@@ -183,10 +315,42 @@ func ingestSystem() *ingest.System {
 	}
 
 	i := ingest.New(passphrase, config.StellarCoreURL, cdb, hdb)
+	i.Outbox = config.IngestOutbox
+
+	// wait for a running horizon to finish writing rather than fail
+	i.LockMode = ingest.LockWait
 	return i
 }
 
-func reingest(i *ingest.System, args []string) (int, error) {
+func reingest(i *ingest.System, cmd *cobra.Command, args []string) (int, error) {
+	flags := cmd.Flags()
+	changed := func(name string) bool {
+		return flags.Lookup(name).Changed
+	}
+
+	if changed("parallel") || changed("from") || changed("to") {
+		if len(args) > 0 {
+			return 0, errors.New("ledger arguments cannot be combined with --parallel, --from or --to")
+		}
+
+		parallel, err := flags.GetInt("parallel")
+		if err != nil {
+			return 0, err
+		}
+
+		from, err := flags.GetInt("from")
+		if err != nil {
+			return 0, err
+		}
+
+		to, err := flags.GetInt("to")
+		if err != nil {
+			return 0, err
+		}
+
+		return i.ReingestRangeParallel(int32(from), int32(to), parallel)
+	}
+
 	if len(args) == 0 {
 		count, err := i.ReingestAll()
 		return count, err
@@ -210,3 +374,69 @@ func reingest(i *ingest.System, args []string) (int, error) {
 	}
 	return len(args), nil
 }
+
+func verify(i *ingest.System, cmd *cobra.Command) (*verifyResult, error) {
+	flags := cmd.Flags()
+
+	from, err := flags.GetInt("from")
+	if err != nil {
+		return nil, err
+	}
+
+	to, err := flags.GetInt("to")
+	if err != nil {
+		return nil, err
+	}
+
+	fix, err := flags.GetBool("fix")
+	if err != nil {
+		return nil, err
+	}
+
+	start, end := int32(from), int32(to)
+	q := &history.Q{Session: i.HorizonDB}
+
+	if start == 0 {
+		err = q.ElderLedger(&start)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if end == 0 {
+		err = q.LatestLedger(&end)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if start == 0 || start > end {
+		return nil, fmt.Errorf("invalid ledger range: %d to %d", start, end)
+	}
+
+	report, err := i.VerifyRange(start, end)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &verifyResult{
+		VerifyReport: report,
+		Tables:       report.Tables(),
+		Ledgers:      report.Ledgers(),
+		Fixed:        []int32{},
+	}
+
+	if !fix {
+		return result, nil
+	}
+
+	for _, seq := range result.Ledgers {
+		err = i.ReingestSingle(seq)
+		if err != nil {
+			return result, fmt.Errorf("failed to reingest ledger %d: %s", seq, err)
+		}
+		result.Fixed = append(result.Fixed, seq)
+	}
+
+	return result, nil
+}
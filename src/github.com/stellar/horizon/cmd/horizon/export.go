@@ -0,0 +1,82 @@
+package main
+
+import (
+	"log"
+
+	"github.com/spf13/cobra"
+	"github.com/stellar/go/support/db"
+	"github.com/stellar/horizon/export"
+	hlog "github.com/stellar/horizon/log"
+	"golang.org/x/net/context"
+)
+
+var exportCmd = &cobra.Command{
+	Use:   "export",
+	Short: "delivers ingested data to an external sink",
+	Long: "export tails the events written to the outbox by an ingesting horizon " +
+		"started with --ingest-outbox, delivering each of them once to the sink " +
+		"given by --sink: a file path or file:// url, to which events are appended " +
+		"as lines of JSON, or an http:// or https:// url, to which batches of " +
+		"events are posted as JSON arrays.",
+	Run: func(cmd *cobra.Command, args []string) {
+		initConfig()
+		hlog.DefaultLogger.Logger.Level = config.LogLevel
+
+		flags := cmd.Flags()
+		target, err := flags.GetString("sink")
+		if err != nil {
+			log.Fatal(err)
+		}
+		if target == "" {
+			log.Fatal("Invalid config: --sink is required")
+		}
+
+		name, err := flags.GetString("name")
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		batchSize, err := flags.GetInt("batch-size")
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		sink, err := export.NewSink(target)
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		hdb, err := db.Open("postgres", config.DatabaseURL)
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		e := export.New(name, sink, hdb)
+		if batchSize > 0 {
+			e.BatchSize = uint64(batchSize)
+		}
+
+		hlog.WithField("export", name).WithField("sink", target).Info("export: starting")
+		e.Run(context.Background())
+	},
+}
+
+func init() {
+	exportCmd.Flags().String(
+		"sink",
+		"",
+		"where events are delivered: a file path, a file:// url or an http(s):// url",
+	)
+
+	exportCmd.Flags().String(
+		"name",
+		"default",
+		"the name of the export, used to track the events it has delivered",
+	)
+
+	exportCmd.Flags().Int(
+		"batch-size",
+		export.DefaultBatchSize,
+		"the maximum number of events delivered to the sink at once",
+	)
+}
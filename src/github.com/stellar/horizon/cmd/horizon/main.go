@@ -3,13 +3,16 @@ package main
 import (
 	"log"
 	"runtime"
+	"strings"
 
 	"github.com/PuerkitoBio/throttled"
 	"github.com/sirupsen/logrus"
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
 	"github.com/stellar/horizon"
+	"github.com/stellar/horizon/ingest"
 	hlog "github.com/stellar/horizon/log"
+	"github.com/stellar/horizon/txsub/sequence"
 )
 
 var app *horizon.App
@@ -29,6 +32,7 @@ func main() {
 func init() {
 	viper.SetDefault("port", 8000)
 	viper.SetDefault("history-retention-count", 0)
+	viper.SetDefault("path-finder", "simple")
 
 	viper.BindEnv("port", "PORT")
 	viper.BindEnv("db-url", "DATABASE_URL")
@@ -49,6 +53,13 @@ func init() {
 	viper.BindEnv("history-retention-count", "HISTORY_RETENTION_COUNT")
 	viper.BindEnv("history-stale-threshold", "HISTORY_STALE_THRESHOLD")
 	viper.BindEnv("skip-cursor-update", "SKIP_CURSOR_UPDATE")
+	viper.BindEnv("ledger-close-notify", "LEDGER_CLOSE_NOTIFY")
+	viper.BindEnv("path-finder", "PATH_FINDER")
+	viper.BindEnv("stellar-core-submit-urls", "STELLAR_CORE_SUBMIT_URLS")
+	viper.BindEnv("ingest-outbox", "INGEST_OUTBOX")
+	viper.BindEnv("ingest-repair-window", "INGEST_REPAIR_WINDOW")
+	viper.BindEnv("max-submission-queue-size", "MAX_SUBMISSION_QUEUE_SIZE")
+	viper.BindEnv("max-account-submission-queue-size", "MAX_ACCOUNT_SUBMISSION_QUEUE_SIZE")
 
 	rootCmd = &cobra.Command{
 		Use:   "horizon",
@@ -162,7 +173,50 @@ func init() {
 		"the maximum number of ledgers the history db is allowed to be out of date from the connected stellar-core db before horizon considers history stale",
 	)
 
+	rootCmd.Flags().Bool(
+		"ledger-close-notify",
+		false,
+		"installs a trigger in the stellar-core db so that horizon is notified as soon as a ledger closes, rather than polling once per second",
+	)
+
+	rootCmd.Flags().String(
+		"path-finder",
+		"simple",
+		"the path finding implementation to use: \"simple\" queries stellar-core's db for each request, \"memory\" searches an in-memory copy of the order books",
+	)
+
+	rootCmd.Flags().String(
+		"stellar-core-submit-urls",
+		"",
+		"comma-separated stellar-cores to submit transactions to, failing over between them (defaults to stellar-core-url)",
+	)
+
+	rootCmd.Flags().Bool(
+		"ingest-outbox",
+		false,
+		"causes ingestion to write an event for each ingested ledger, transaction, operation, effect and trade to the outbox_events table, for delivery by horizon export",
+	)
+
+	rootCmd.Flags().Int(
+		"ingest-repair-window",
+		ingest.DefaultRepairWindow,
+		"the maximum number of ledgers ingestion reingests to repair history that no longer matches stellar-core's ledger chain, or 0 to halt ingestion instead",
+	)
+
+	rootCmd.Flags().Int(
+		"max-submission-queue-size",
+		sequence.DefaultMaxSize,
+		"the maximum number of transaction submissions that may wait for their turn to be submitted to stellar-core",
+	)
+
+	rootCmd.Flags().Int(
+		"max-account-submission-queue-size",
+		sequence.DefaultMaxAccountSize,
+		"the maximum number of transaction submissions from a single source account that may wait for their turn to be submitted to stellar-core, or 0 for no limit beyond the fair share of a busy queue",
+	)
+
 	rootCmd.AddCommand(dbCmd)
+	rootCmd.AddCommand(exportCmd)
 
 	viper.BindPFlags(rootCmd.Flags())
 }
@@ -208,6 +262,19 @@ func initConfig() {
 		log.Fatal("Invalid TLS config: cert not configured")
 	}
 
+	switch viper.GetString("path-finder") {
+	case "simple", "memory":
+	default:
+		log.Fatalf("Invalid config: unknown path-finder %q.  Please specify either \"simple\" or \"memory\".", viper.GetString("path-finder"))
+	}
+
+	var submitURLs []string
+	for _, u := range strings.Split(viper.GetString("stellar-core-submit-urls"), ",") {
+		if u = strings.TrimSpace(u); u != "" {
+			submitURLs = append(submitURLs, u)
+		}
+	}
+
 	config = horizon.Config{
 		DatabaseURL:            viper.GetString("db-url"),
 		StellarCoreDatabaseURL: viper.GetString("stellar-core-db-url"),
@@ -226,5 +293,13 @@ func initConfig() {
 		HistoryRetentionCount:  uint(viper.GetInt("history-retention-count")),
 		StaleThreshold:         uint(viper.GetInt("history-stale-threshold")),
 		SkipCursorUpdate:       viper.GetBool("skip-cursor-update"),
+		LedgerCloseNotify:      viper.GetBool("ledger-close-notify"),
+		PathFinder:             viper.GetString("path-finder"),
+		StellarCoreSubmitURLs:  submitURLs,
+		IngestOutbox:           viper.GetBool("ingest-outbox"),
+		IngestRepairWindow:     viper.GetInt("ingest-repair-window"),
+
+		MaxSubmissionQueueSize:        viper.GetInt("max-submission-queue-size"),
+		MaxAccountSubmissionQueueSize: viper.GetInt("max-account-submission-queue-size"),
 	}
 }
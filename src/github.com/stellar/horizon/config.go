@@ -40,4 +40,41 @@ type Config struct {
 	// SkipCursorUpdate causes the ingestor to skip reporting the "last imported
 	// ledger" state to stellar-core.
 	SkipCursorUpdate bool
+
+	// LedgerCloseNotify causes horizon to install a trigger on stellar-core's
+	// `ledgerheaders` table and to tick as soon as a ledger closes, rather
+	// than waiting for the next once-per-second tick.
+	LedgerCloseNotify bool
+
+	// PathFinder selects the path finding implementation: "simple" queries the
+	// stellar-core database on each request, while "memory" searches an
+	// in-memory copy of the order books that is updated as ledgers close.
+	PathFinder string
+
+	// StellarCoreSubmitURLs lists the stellar-core instances that transactions
+	// are submitted to.  Submissions go to the first of them that is in sync
+	// with the network, failing over to the others.  When empty, transactions
+	// are submitted to StellarCoreURL.
+	StellarCoreSubmitURLs []string
+
+	// IngestOutbox causes the ingester to write an event to the
+	// `outbox_events` table for each ledger, transaction, operation, effect and
+	// trade it ingests, for delivery by `horizon export`.
+	IngestOutbox bool
+
+	// IngestRepairWindow is the number of ledgers the ingester may reingest to
+	// repair the history database when its latest ledger does not match the
+	// ledger chain in stellar-core.  When zero, ingestion halts instead.
+	IngestRepairWindow int
+
+	// MaxSubmissionQueueSize is the number of submissions that may wait for
+	// their turn to be submitted to stellar-core.  When zero, the default of
+	// the sequence package is used.
+	MaxSubmissionQueueSize int
+
+	// MaxAccountSubmissionQueueSize is the number of submissions from a
+	// single source account that may wait for their turn to be submitted to
+	// stellar-core.  When zero, a source account may use the whole queue
+	// until it is half full.
+	MaxAccountSubmissionQueueSize int
 }
@@ -0,0 +1,56 @@
+package core
+
+import (
+	sq "github.com/Masterminds/squirrel"
+	"github.com/stellar/go/support/errors"
+	"github.com/stellar/go/xdr"
+)
+
+// AssetStatByAsset loads `dest` with the statistics of `asset`, aggregated from
+// every trustline that currently holds it.  The flags are those of the
+// asset's issuer.
+func (q *Q) AssetStatByAsset(dest interface{}, asset xdr.Asset) error {
+	var (
+		typ          xdr.AssetType
+		code, issuer string
+	)
+
+	err := asset.Extract(&typ, &code, &issuer)
+	if err != nil {
+		return errors.Wrap(err, "failed to extract asset")
+	}
+
+	sql := sq.Select(
+		"COUNT(tl.accountid) AS num_accounts",
+		"COALESCE(SUM(tl.balance), 0) AS amount",
+		"COALESCE(MAX(a.flags), 0) AS flags",
+	).
+		From("trustlines tl").
+		LeftJoin("accounts a ON a.accountid = tl.issuer").
+		Where(sq.Eq{
+			"tl.assettype": typ,
+			"tl.assetcode": code,
+			"tl.issuer":    issuer,
+		})
+
+	return q.Get(dest, sql)
+}
+
+// AssetStats loads `dest` with the statistics of every asset held by at least
+// one trustline, as AssetStatByAsset does for a single asset.
+func (q *Q) AssetStats(dest interface{}) error {
+	sql := sq.Select(
+		"tl.assettype",
+		"tl.assetcode",
+		"tl.issuer",
+		"COUNT(tl.accountid) AS num_accounts",
+		"COALESCE(SUM(tl.balance), 0) AS amount",
+		"COALESCE(MAX(a.flags), 0) AS flags",
+	).
+		From("trustlines tl").
+		LeftJoin("accounts a ON a.accountid = tl.issuer").
+		GroupBy("tl.assettype", "tl.assetcode", "tl.issuer").
+		OrderBy("tl.assettype", "tl.assetcode", "tl.issuer")
+
+	return q.Select(dest, sql)
+}
@@ -0,0 +1,52 @@
+package core
+
+import (
+	"testing"
+
+	"github.com/stellar/go/build"
+	"github.com/stellar/go/xdr"
+	"github.com/stellar/horizon/test"
+)
+
+func TestAssetStatByAsset(t *testing.T) {
+	tt := test.Start(t).Scenario("trades")
+	defer tt.Finish()
+	q := &Q{tt.CoreSession()}
+
+	var stat AssetStat
+	usd := build.CreditAsset("USD", "GC23QF2HUE52AMXUFUH3AYJAXXGXXV2VHXYYR6EYXETPKDXZSAW67XO4")
+	err := q.AssetStatByAsset(&stat, usd.MustXDR())
+	if tt.Assert.NoError(err) {
+		tt.Assert.Equal(int32(2), stat.NumAccounts)
+		tt.Assert.Equal(xdr.Int64(5000000000), stat.Amount)
+		tt.Assert.Equal(xdr.AccountFlags(0), stat.Flags)
+	}
+
+	// unknown asset
+	stat = AssetStat{}
+	nope := build.CreditAsset("NOPE", "GC23QF2HUE52AMXUFUH3AYJAXXGXXV2VHXYYR6EYXETPKDXZSAW67XO4")
+	err = q.AssetStatByAsset(&stat, nope.MustXDR())
+	if tt.Assert.NoError(err) {
+		tt.Assert.Equal(int32(0), stat.NumAccounts)
+		tt.Assert.Equal(xdr.Int64(0), stat.Amount)
+	}
+}
+
+func TestAssetStats(t *testing.T) {
+	tt := test.Start(t).Scenario("trades")
+	defer tt.Finish()
+	q := &Q{tt.CoreSession()}
+
+	var stats []AssetStatRow
+	err := q.AssetStats(&stats)
+	if tt.Assert.NoError(err) && tt.Assert.Len(stats, 2) {
+		tt.Assert.Equal("EUR", stats[0].AssetCode)
+		tt.Assert.Equal("USD", stats[1].AssetCode)
+
+		for _, stat := range stats {
+			tt.Assert.Equal(xdr.AssetTypeAssetTypeCreditAlphanum4, stat.AssetType)
+			tt.Assert.Equal(int32(2), stat.NumAccounts)
+			tt.Assert.Equal(xdr.Int64(5000000000), stat.Amount)
+		}
+	}
+}
@@ -0,0 +1,67 @@
+package core
+
+import (
+	"github.com/stellar/go/support/errors"
+)
+
+// LedgerCloseChannel is the postgres notification channel on which the
+// trigger installed by InstallLedgerCloseTrigger announces each ledger header
+// written by stellar-core.  The payload is the ledger's sequence.
+const LedgerCloseChannel = "horizon_ledger_close"
+
+const ledgerCloseFunction = `
+	CREATE OR REPLACE FUNCTION horizon_notify_ledger_close() RETURNS trigger AS $$
+	BEGIN
+		PERFORM pg_notify('` + LedgerCloseChannel + `', NEW.ledgerseq::text);
+		RETURN NEW;
+	END;
+	$$ LANGUAGE plpgsql`
+
+const ledgerCloseTriggerExists = `
+	SELECT EXISTS (
+		SELECT 1 FROM pg_trigger
+		WHERE tgname = 'horizon_ledger_close'
+		AND tgrelid = 'ledgerheaders'::regclass
+	)`
+
+const ledgerCloseTrigger = `
+	CREATE TRIGGER horizon_ledger_close
+		AFTER INSERT ON ledgerheaders
+		FOR EACH ROW EXECUTE PROCEDURE horizon_notify_ledger_close()`
+
+// InstallLedgerCloseTrigger installs a trigger on the `ledgerheaders` table
+// that notifies LedgerCloseChannel whenever stellar-core closes a ledger.  The
+// connected role must be allowed to create functions and triggers in the
+// stellar-core database.  Notifications are delivered once stellar-core
+// commits the ledger.
+//
+// The trigger function is replaced on every call, but the trigger is only
+// created if it is missing: creating a trigger locks the table exclusively,
+// which would stall stellar-core's ledger close.
+func (q *Q) InstallLedgerCloseTrigger() error {
+	err := q.Begin()
+	if err != nil {
+		return errors.Wrap(err, "begin failed")
+	}
+	defer q.Rollback()
+
+	_, err = q.ExecRaw(ledgerCloseFunction)
+	if err != nil {
+		return errors.Wrap(err, "install ledger close function failed")
+	}
+
+	var exists bool
+	err = q.GetRaw(&exists, ledgerCloseTriggerExists)
+	if err != nil {
+		return errors.Wrap(err, "check ledger close trigger failed")
+	}
+
+	if !exists {
+		_, err = q.ExecRaw(ledgerCloseTrigger)
+		if err != nil {
+			return errors.Wrap(err, "install ledger close trigger failed")
+		}
+	}
+
+	return q.Commit()
+}
@@ -0,0 +1,49 @@
+package core
+
+import (
+	"testing"
+	"time"
+
+	"github.com/lib/pq"
+	"github.com/stellar/horizon/test"
+)
+
+func TestInstallLedgerCloseTrigger(t *testing.T) {
+	tt := test.Start(t).ScenarioWithoutHorizon("base")
+	defer tt.Finish()
+	q := &Q{tt.CoreSession()}
+
+	triggerOID := func() int64 {
+		var oid int64
+		err := q.GetRaw(&oid, `
+			SELECT oid::bigint FROM pg_trigger WHERE tgname = 'horizon_ledger_close'
+		`)
+		tt.Require.NoError(err)
+		return oid
+	}
+
+	// installing is idempotent, and leaves an existing trigger in place
+	tt.Require.NoError(q.InstallLedgerCloseTrigger())
+	installed := triggerOID()
+	tt.Require.NoError(q.InstallLedgerCloseTrigger())
+	tt.Assert.Equal(installed, triggerOID())
+
+	listener := pq.NewListener(test.StellarCoreDatabaseURL(), time.Second, time.Second, nil)
+	defer listener.Close()
+	tt.Require.NoError(listener.Listen(LedgerCloseChannel))
+
+	_, err := tt.CoreDB.Exec(`
+		INSERT INTO ledgerheaders
+		SELECT md5(ledgerhash) || md5(ledgerhash), ledgerhash, bucketlisthash,
+			ledgerseq + 1, closetime, data
+		FROM ledgerheaders WHERE ledgerseq = 3
+	`)
+	tt.Require.NoError(err)
+
+	select {
+	case n := <-listener.Notify:
+		tt.Assert.Equal("4", n.Extra)
+	case <-time.After(5 * time.Second):
+		tt.Assert.Fail("no notification received")
+	}
+}
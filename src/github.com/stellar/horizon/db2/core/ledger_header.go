@@ -14,3 +14,27 @@ func (q *Q) LedgerHeaderBySequence(dest interface{}, seq int32) error {
 
 	return q.Get(dest, sql)
 }
+
+// LedgerHashes loads the hashes of the ledgers from `start` to `end`,
+// inclusive, into `dest`, keyed by sequence.
+func (q *Q) LedgerHashes(dest map[int32]string, start, end int32) error {
+	sql := sq.Select("clh.ledgerseq", "clh.ledgerhash").
+		From("ledgerheaders clh").
+		Where("clh.ledgerseq BETWEEN ? AND ?", start, end)
+
+	var rows []struct {
+		Sequence int32  `db:"ledgerseq"`
+		Hash     string `db:"ledgerhash"`
+	}
+
+	err := q.Select(&rows, sql)
+	if err != nil {
+		return err
+	}
+
+	for _, row := range rows {
+		dest[row.Sequence] = row.Hash
+	}
+
+	return nil
+}
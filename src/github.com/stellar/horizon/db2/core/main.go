@@ -28,6 +28,23 @@ type AccountData struct {
 	Value     string `db:"datavalue"`
 }
 
+// AssetStat represents the statistics of a single asset, aggregated from the
+// trustlines that hold it in stellar-core's `trustlines` table.
+type AssetStat struct {
+	Amount      xdr.Int64        `db:"amount"`
+	NumAccounts int32            `db:"num_accounts"`
+	Flags       xdr.AccountFlags `db:"flags"`
+}
+
+// AssetStatRow is the statistics of an asset along with the asset they
+// describe, as loaded by AssetStats.
+type AssetStatRow struct {
+	AssetType xdr.AssetType `db:"assettype"`
+	AssetCode string        `db:"assetcode"`
+	Issuer    string        `db:"issuer"`
+	AssetStat
+}
+
 // LedgerHeader is row of data from the `ledgerheaders` table
 type LedgerHeader struct {
 	LedgerHash     string           `db:"ledgerhash"`
@@ -24,6 +24,20 @@ func (r Offer) PriceAsString() string {
 // finding.  Given the input asset type, a list of xdr.Assets is returned that
 // each have some available trades for the input asset.
 func (q *Q) ConnectedAssets(dest interface{}, selling xdr.Asset) error {
+	return q.connectedAssets(dest, "selling", "buying", selling)
+}
+
+// ConnectedSellingAssets loads xdr.Asset records for the purposes of strict
+// send path finding.  Given the input asset, a list of xdr.Assets is returned
+// that are each sold by some offer in exchange for the input asset.
+func (q *Q) ConnectedSellingAssets(dest interface{}, buying xdr.Asset) error {
+	return q.connectedAssets(dest, "buying", "selling", buying)
+}
+
+// connectedAssets loads the distinct `to` assets of the offers whose `from`
+// asset is `asset`, where `from` and `to` are each one of "selling" or
+// "buying".
+func (q *Q) connectedAssets(dest interface{}, from, to string, asset xdr.Asset) error {
 
 	assets, ok := dest.(*[]xdr.Asset)
 	if !ok {
@@ -36,21 +50,21 @@ func (q *Q) ConnectedAssets(dest interface{}, selling xdr.Asset) error {
 		i string
 	)
 
-	err := selling.Extract(&t, &c, &i)
+	err := asset.Extract(&t, &c, &i)
 	if err != nil {
 		return err
 	}
 
 	sql := sq.Select(
-		"buyingassettype AS type",
-		"coalesce(buyingassetcode, '') AS code",
-		"coalesce(buyingissuer, '') AS issuer").
+		to+"assettype AS type",
+		"coalesce("+to+"assetcode, '') AS code",
+		"coalesce("+to+"issuer, '') AS issuer").
 		From("offers").
-		Where(sq.Eq{"sellingassettype": t}).
-		GroupBy("buyingassettype", "buyingassetcode", "buyingissuer")
+		Where(sq.Eq{from + "assettype": t}).
+		GroupBy(to+"assettype", to+"assetcode", to+"issuer")
 
 	if t != xdr.AssetTypeAssetTypeNative {
-		sql = sql.Where(sq.Eq{"sellingassetcode": c, "sellingissuer": i})
+		sql = sql.Where(sq.Eq{from + "assetcode": c, from + "issuer": i})
 	}
 
 	var rows []struct {
@@ -78,6 +92,16 @@ func (q *Q) ConnectedAssets(dest interface{}, selling xdr.Asset) error {
 	return nil
 }
 
+// OfferByID loads the offer identified by `id` from the ledger.
+func (q *Q) OfferByID(dest interface{}, id int64) error {
+	sql := sq.Select("co.*").
+		From("offers co").
+		Where("co.offerid = ?", id).
+		Limit(1)
+
+	return q.Get(dest, sql)
+}
+
 // OffersByAddress loads a page of active offers for the given
 // address.
 func (q *Q) OffersByAddress(dest interface{}, addy string, pq db2.PageQuery) error {
@@ -100,3 +124,12 @@ func (q *Q) OffersByAddress(dest interface{}, addy string, pq db2.PageQuery) err
 
 	return q.Select(dest, sql)
 }
+
+// AllOffers loads every offer present in the ledger.
+func (q *Q) AllOffers(dest interface{}) error {
+	sql := sq.Select("co.*").
+		From("offers co").
+		OrderBy("co.offerid asc")
+
+	return q.Select(dest, sql)
+}
@@ -69,3 +69,19 @@ func TestOffersByAddress(t *testing.T) {
 		tt.Assert.Equal(int64(2), offers[0].OfferID)
 	}
 }
+
+func TestOfferByID(t *testing.T) {
+	tt := test.Start(t).Scenario("trades")
+	defer tt.Finish()
+	q := &Q{tt.CoreSession()}
+
+	var offer Offer
+	err := q.OfferByID(&offer, 4)
+	if tt.Assert.NoError(err) {
+		tt.Assert.Equal(int64(4), offer.OfferID)
+		tt.Assert.Equal("GCXKG6RN4ONIEPCMNFB732A436Z5PNDSRLGWK7GBLCMQLIFO4S7EYWVU", offer.SellerID)
+	}
+
+	err = q.OfferByID(&offer, 100)
+	tt.Assert.True(q.NoRows(err))
+}
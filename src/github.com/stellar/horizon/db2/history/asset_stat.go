@@ -0,0 +1,66 @@
+package history
+
+import (
+	"strconv"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/stellar/horizon/db2"
+)
+
+// PagingToken returns a cursor for this asset stat
+func (r *AssetStat) PagingToken() string {
+	return strconv.FormatInt(r.ID, 10)
+}
+
+// AssetStats provides a helper to filter rows from the `asset_stats` table
+// with pre-defined filters.  See `AssetStatsQ` methods for the available
+// filters.
+func (q *Q) AssetStats() *AssetStatsQ {
+	return &AssetStatsQ{
+		parent: q,
+		sql:    selectAssetStat,
+	}
+}
+
+// ForCode filters the query to only include assets with the provided code.
+func (q *AssetStatsQ) ForCode(code string) *AssetStatsQ {
+	q.sql = q.sql.Where("ast.asset_code = ?", code)
+	return q
+}
+
+// ForIssuer filters the query to only include assets issued by the account
+// identified by `issuer`.
+func (q *AssetStatsQ) ForIssuer(issuer string) *AssetStatsQ {
+	q.sql = q.sql.Where("ast.asset_issuer = ?", issuer)
+	return q
+}
+
+// Page specifies the paging constraints for the query being built by `q`.
+func (q *AssetStatsQ) Page(page db2.PageQuery) *AssetStatsQ {
+	if q.Err != nil {
+		return q
+	}
+
+	q.sql, q.Err = page.ApplyTo(q.sql, "ast.id")
+	return q
+}
+
+// Select loads the results of the query specified by `q` into `dest`.
+func (q *AssetStatsQ) Select(dest interface{}) error {
+	if q.Err != nil {
+		return q.Err
+	}
+
+	q.Err = q.parent.Select(dest, q.sql)
+	return q.Err
+}
+
+var selectAssetStat = sq.Select(
+	"ast.id",
+	"ast.asset_type",
+	"ast.asset_code",
+	"ast.asset_issuer",
+	"ast.amount",
+	"ast.num_accounts",
+	"ast.flags",
+).From("asset_stats ast")
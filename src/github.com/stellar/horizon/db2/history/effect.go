@@ -52,6 +52,22 @@ func (q *Q) Effects() *EffectsQ {
 	}
 }
 
+// LastOfferEffect loads the latest offer_created, offer_updated or
+// offer_removed effect of the offer identified by `id`.
+func (q *Q) LastOfferEffect(dest *Effect, id int64) error {
+	sql := selectEffect.
+		Where("(heff.details->>'offer_id')::bigint = ?", id).
+		Where(sq.Eq{"heff.type": []EffectType{
+			EffectOfferCreated,
+			EffectOfferUpdated,
+			EffectOfferRemoved,
+		}}).
+		OrderBy("heff.history_operation_id desc, heff.order desc").
+		Limit(1)
+
+	return q.Get(dest, sql)
+}
+
 // ForAccount filters the operations collection to a specific account
 func (q *EffectsQ) ForAccount(aid string) *EffectsQ {
 	var account Account
@@ -100,6 +116,13 @@ func (q *EffectsQ) ForOperation(id int64) *EffectsQ {
 	return q
 }
 
+// ForOffer filters the query to only effects whose details indicate that the
+// effect concerns the offer identified by `id`.
+func (q *EffectsQ) ForOffer(id int64) *EffectsQ {
+	q.sql = q.sql.Where("(heff.details->>'offer_id')::bigint = ?", id)
+	return q
+}
+
 // ForOrderBook filters the query to only effects whose details indicate that
 // the effect is for a specific asset pair.
 func (q *EffectsQ) ForOrderBook(selling, buying xdr.Asset) *EffectsQ {
@@ -17,6 +17,30 @@ func (q *Q) LedgerBySequence(dest interface{}, seq int32) error {
 	return q.Get(dest, sql)
 }
 
+// LedgerHashes loads the hashes of the ledgers from `start` to `end`,
+// inclusive, into `dest`, keyed by sequence.
+func (q *Q) LedgerHashes(dest map[int32]string, start, end int32) error {
+	sql := sq.Select("hl.sequence", "hl.ledger_hash").
+		From("history_ledgers hl").
+		Where("hl.sequence BETWEEN ? AND ?", start, end)
+
+	var rows []struct {
+		Sequence int32  `db:"sequence"`
+		Hash     string `db:"ledger_hash"`
+	}
+
+	err := q.Select(&rows, sql)
+	if err != nil {
+		return err
+	}
+
+	for _, row := range rows {
+		dest[row.Sequence] = row.Hash
+	}
+
+	return nil
+}
+
 // Ledgers provides a helper to filter rows from the `history_ledgers` table
 // with pre-defined filters.  See `LedgersQ` methods for the available filters.
 func (q *Q) Ledgers() *LedgersQ {
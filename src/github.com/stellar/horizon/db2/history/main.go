@@ -113,6 +113,25 @@ type AccountsQ struct {
 	sql    sq.SelectBuilder
 }
 
+// AssetStat is a row of data from the `asset_stats` table
+type AssetStat struct {
+	ID          int64            `db:"id"`
+	AssetType   string           `db:"asset_type"`
+	AssetCode   string           `db:"asset_code"`
+	AssetIssuer string           `db:"asset_issuer"`
+	Amount      xdr.Int64        `db:"amount"`
+	NumAccounts int32            `db:"num_accounts"`
+	Flags       xdr.AccountFlags `db:"flags"`
+}
+
+// AssetStatsQ is a helper struct to aid in configuring queries that loads
+// slices of asset stat structs.
+type AssetStatsQ struct {
+	Err    error
+	parent *Q
+	sql    sq.SelectBuilder
+}
+
 // Effect is a row of data from the `history_effects` table
 type Effect struct {
 	HistoryAccountID   int64       `db:"history_account_id"`
@@ -181,14 +200,39 @@ type Operation struct {
 	Type             xdr.OperationType `db:"type"`
 	DetailsString    null.String       `db:"details"`
 	SourceAccount    string            `db:"source_account"`
+
+	// TransactionSuccessful is false when the operation belongs to a
+	// transaction that failed when applied by stellar-core.
+	TransactionSuccessful bool `db:"transaction_successful"`
 }
 
 // OperationsQ is a helper struct to aid in configuring queries that loads
 // slices of Operation structs.
 type OperationsQ struct {
-	Err    error
-	parent *Q
-	sql    sq.SelectBuilder
+	Err           error
+	parent        *Q
+	sql           sq.SelectBuilder
+	includeFailed bool
+}
+
+// OutboxEvent is a row of data from the `outbox_events` table
+type OutboxEvent struct {
+	ID             int64     `db:"id"`
+	Type           string    `db:"type"`
+	HistoryID      int64     `db:"history_id"`
+	LedgerSequence int32     `db:"ledger_sequence"`
+	Details        []byte    `db:"details"`
+	CreatedAt      time.Time `db:"created_at"`
+	TxID           int64     `db:"txid"`
+	Reingested     bool      `db:"reingested"`
+}
+
+// OutboxPosition is the position of an event in the order outbox events are
+// delivered: by the id of the database transaction that wrote them, then by
+// event id.
+type OutboxPosition struct {
+	TxID    int64 `db:"last_txid"`
+	EventID int64 `db:"last_event_id"`
 }
 
 // Q is a helper struct on which to hang common queries against a history
@@ -220,6 +264,39 @@ type Trade struct {
 	BoughtAmount       xdr.Int64 `db:"bought_amount"`
 }
 
+// OfferTrades holds the totals of the trades made against an offer.
+type OfferTrades struct {
+	Count           int64     `db:"count"`
+	SoldAmount      xdr.Int64 `db:"sold_amount"`
+	BoughtAmount    xdr.Int64 `db:"bought_amount"`
+	LastOperationID int64     `db:"last_operation_id"`
+}
+
+// TradeAggregation is a row of data produced by aggregating the rows of the
+// `history_trades` table into buckets of a fixed resolution.  Amounts are
+// expressed in terms of the base asset of the aggregation, prices as the
+// amount of the counter asset paid for one unit of the base asset.
+type TradeAggregation struct {
+	Timestamp     int64     `db:"timestamp"`
+	TradeCount    int64     `db:"count"`
+	BaseVolume    xdr.Int64 `db:"base_volume"`
+	CounterVolume xdr.Int64 `db:"counter_volume"`
+	Average       string    `db:"avg"`
+	High          string    `db:"high"`
+	Low           string    `db:"low"`
+	Open          string    `db:"open"`
+	Close         string    `db:"close"`
+}
+
+// TradeAggregationsQ is a helper struct to aid in configuring queries that
+// load slices of trade aggregation structs.
+type TradeAggregationsQ struct {
+	Err    error
+	parent *Q
+	sql    sq.SelectBuilder
+	trades sq.SelectBuilder
+}
+
 // TradesQ is a helper struct to aid in configuring queries that loads
 // slices of trade structs.
 type TradesQ struct {
@@ -248,6 +325,7 @@ type Transaction struct {
 	Memo             null.String `db:"memo"`
 	ValidAfter       null.Int    `db:"valid_after"`
 	ValidBefore      null.Int    `db:"valid_before"`
+	Successful       bool        `db:"successful"`
 	CreatedAt        time.Time   `db:"created_at"`
 	UpdatedAt        time.Time   `db:"updated_at"`
 }
@@ -255,9 +333,10 @@ type Transaction struct {
 // TransactionsQ is a helper struct to aid in configuring queries that loads
 // slices of transaction structs.
 type TransactionsQ struct {
-	Err    error
-	parent *Q
-	sql    sq.SelectBuilder
+	Err           error
+	parent        *Q
+	sql           sq.SelectBuilder
+	includeFailed bool
 }
 
 // ElderLedger loads the oldest ledger known to the history database
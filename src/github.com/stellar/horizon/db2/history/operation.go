@@ -105,6 +105,14 @@ func (q *OperationsQ) ForTransaction(hash string) *OperationsQ {
 	return q
 }
 
+// IncludeFailed changes the query to include operations from failed
+// transactions.  By default, only operations from successful transactions are
+// loaded.
+func (q *OperationsQ) IncludeFailed() *OperationsQ {
+	q.includeFailed = true
+	return q
+}
+
 // OnlyPayments filters the query being built to only include operations that
 // are in the "payment" class of operations:  CreateAccountOps, Payments, and
 // PathPayments.
@@ -134,6 +142,10 @@ func (q *OperationsQ) Select(dest interface{}) error {
 		return q.Err
 	}
 
+	if !q.includeFailed {
+		q.sql = q.sql.Where("(ht.successful = true OR ht.successful IS NULL)")
+	}
+
 	q.Err = q.parent.Select(dest, q.sql)
 	return q.Err
 }
@@ -145,6 +157,7 @@ var selectOperation = sq.Select(
 		"hop.type, " +
 		"hop.details, " +
 		"hop.source_account, " +
-		"ht.transaction_hash").
+		"ht.transaction_hash, " +
+		"COALESCE(ht.successful, true) AS transaction_successful").
 	From("history_operations hop").
 	LeftJoin("history_transactions ht ON ht.id = hop.transaction_id")
@@ -46,6 +46,27 @@ func TestOperationQueries(t *testing.T) {
 		tt.Assert.Len(ops, 1)
 	}
 
+	// operations of failed transactions are excluded unless requested
+	_, err = q.ExecRaw(
+		"UPDATE history_transactions SET successful = false WHERE transaction_hash = ?",
+		hash,
+	)
+	tt.Require.NoError(err)
+
+	ops = []Operation{}
+	err = q.Operations().ForLedger(2).Select(&ops)
+
+	if tt.Assert.NoError(err) {
+		tt.Assert.Len(ops, 2)
+	}
+
+	ops = []Operation{}
+	err = q.Operations().ForTransaction(hash).IncludeFailed().Select(&ops)
+
+	if tt.Assert.NoError(err) && tt.Assert.Len(ops, 1) {
+		tt.Assert.False(ops[0].TransactionSuccessful)
+	}
+
 	// payment filter works
 	tt.Scenario("pathed_payment")
 	ops = []Operation{}
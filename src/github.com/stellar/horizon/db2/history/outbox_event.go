@@ -0,0 +1,97 @@
+package history
+
+import (
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+)
+
+// OutboxEventsAfter loads into `dest` at most `limit` rows of the
+// `outbox_events` table that follow `cursor` in delivery order.  Only events
+// written by transactions whose id is below the xmin of the current snapshot
+// are loaded: those transactions have ended, and any transaction yet to commit
+// has a greater id, so no event can later appear before the loaded ones.
+func (q *Q) OutboxEventsAfter(dest interface{}, cursor OutboxPosition, limit uint64) error {
+	sql := selectOutboxEvent.
+		Where("(oe.txid, oe.id) > (?, ?)", cursor.TxID, cursor.EventID).
+		Where("oe.txid < txid_snapshot_xmin(txid_current_snapshot())").
+		OrderBy("oe.txid asc", "oe.id asc").
+		Limit(limit)
+
+	return q.Select(dest, sql)
+}
+
+// ExportCursor loads into `dest` the position of the last outbox event
+// delivered by the export named `name`.  `dest` is set to the zero position
+// when the export has not delivered any events.
+func (q *Q) ExportCursor(dest *OutboxPosition, name string) error {
+	sql := sq.Select("ec.last_txid", "ec.last_event_id").
+		From("export_cursors ec").
+		Where("ec.name = ?", name)
+
+	err := q.Get(dest, sql)
+	if q.NoRows(err) {
+		*dest = OutboxPosition{}
+		return nil
+	}
+
+	return err
+}
+
+// UpdateExportCursor records that the export named `name` has delivered every
+// outbox event up to and including the one at `pos`.
+func (q *Q) UpdateExportCursor(name string, pos OutboxPosition) error {
+	now := time.Now().UTC()
+
+	res, err := q.Exec(sq.Update("export_cursors").
+		Set("last_txid", pos.TxID).
+		Set("last_event_id", pos.EventID).
+		Set("updated_at", now).
+		Where("name = ?", name))
+	if err != nil {
+		return err
+	}
+
+	updated, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if updated > 0 {
+		return nil
+	}
+
+	_, err = q.Exec(sq.Insert("export_cursors").
+		Columns("name", "last_txid", "last_event_id", "updated_at").
+		Values(name, pos.TxID, pos.EventID, now))
+	return err
+}
+
+// DeleteDeliveredOutboxEvents deletes the rows of the `outbox_events` table
+// whose history id is below `end` and that every export has delivered, that
+// is which are at or before the slowest export cursor.  No event is deleted
+// while no export has recorded a cursor.
+func (q *Q) DeleteDeliveredOutboxEvents(end int64) error {
+	_, err := q.ExecRaw(`
+		DELETE FROM outbox_events
+		WHERE history_id < ?
+		AND (txid, id) <= (
+			SELECT ec.last_txid, ec.last_event_id
+			FROM export_cursors ec
+			ORDER BY ec.last_txid ASC, ec.last_event_id ASC
+			LIMIT 1
+		)
+	`, end)
+	return err
+}
+
+var selectOutboxEvent = sq.Select(
+	"oe.id",
+	"oe.type",
+	"oe.history_id",
+	"oe.ledger_sequence",
+	"oe.details",
+	"oe.created_at",
+	"oe.txid",
+	"oe.reingested",
+).From("outbox_events oe")
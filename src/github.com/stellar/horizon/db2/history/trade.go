@@ -31,6 +31,30 @@ func (q *Q) Trades() *TradesQ {
 	}
 }
 
+// LastOfferTrade loads the latest trade made against the offer identified by
+// `id`.
+func (q *Q) LastOfferTrade(dest *Trade, id int64) error {
+	sql := selectTrade.
+		Where("htrd.offer_id = ?", id).
+		OrderBy("htrd.history_operation_id desc, htrd.order desc").
+		Limit(1)
+
+	return q.Get(dest, sql)
+}
+
+// OfferTradesByID loads the totals of the trades made against the offer
+// identified by `id`.
+func (q *Q) OfferTradesByID(dest *OfferTrades, id int64) error {
+	sql := sq.Select(
+		"COUNT(*) AS count",
+		"COALESCE(SUM(htrd.sold_amount), 0)::bigint AS sold_amount",
+		"COALESCE(SUM(htrd.bought_amount), 0)::bigint AS bought_amount",
+		"COALESCE(MAX(htrd.history_operation_id), 0) AS last_operation_id",
+	).From("history_trades htrd").Where("htrd.offer_id = ?", id)
+
+	return q.Get(dest, sql)
+}
+
 // ForBoughtAsset filters the query to only include trades involving that
 // involved selling the provided asset.
 func (q *TradesQ) ForBoughtAsset(bought xdr.Asset) *TradesQ {
@@ -42,6 +66,20 @@ func (q *TradesQ) ForBoughtAsset(bought xdr.Asset) *TradesQ {
 	return q
 }
 
+// ForLedger filters the query to only trades in a specific ledger, specified
+// by its sequence.
+func (q *TradesQ) ForLedger(seq int32) *TradesQ {
+	start := toid.ID{LedgerSequence: seq}
+	end := toid.ID{LedgerSequence: seq + 1}
+	q.sql = q.sql.Where(
+		"htrd.history_operation_id >= ? AND htrd.history_operation_id < ?",
+		start.ToInt64(),
+		end.ToInt64(),
+	)
+
+	return q
+}
+
 // ForOffer filters the trade query to only return trades that occurred against
 // the offer identified by `id`.
 func (q *TradesQ) ForOffer(id int64) *TradesQ {
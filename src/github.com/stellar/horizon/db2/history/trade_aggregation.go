@@ -0,0 +1,154 @@
+package history
+
+import (
+	"fmt"
+	"strconv"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/stellar/go/support/errors"
+	"github.com/stellar/go/xdr"
+	"github.com/stellar/horizon/db2"
+)
+
+// closedAtMillis is the sql expression for a ledger's close time, expressed
+// in milliseconds since the unix epoch.
+const closedAtMillis = "cast(extract(epoch from hl.closed_at) * 1000 AS bigint)"
+
+// PagingToken returns a cursor for this trade aggregation
+func (r *TradeAggregation) PagingToken() string {
+	return strconv.FormatInt(r.Timestamp, 10)
+}
+
+// TradeAggregations provides a helper to aggregate the trades between `base`
+// and `counter` into buckets of `resolution` milliseconds.  Buckets are
+// aligned to the unix epoch, and trades in either direction are included.
+func (q *Q) TradeAggregations(
+	base xdr.Asset,
+	counter xdr.Asset,
+	resolution int64,
+) *TradeAggregationsQ {
+	result := &TradeAggregationsQ{
+		parent: q,
+		sql:    selectTradeAggregation,
+	}
+
+	if resolution <= 0 {
+		result.Err = errors.New("resolution must be positive")
+		return result
+	}
+
+	var bt, bc, bi, ct, cc, ci string
+	err := base.Extract(&bt, &bc, &bi)
+	if err != nil {
+		result.Err = errors.Wrap(err, "failed to extract base asset")
+		return result
+	}
+
+	err = counter.Extract(&ct, &cc, &ci)
+	if err != nil {
+		result.Err = errors.Wrap(err, "failed to extract counter asset")
+		return result
+	}
+
+	// soldIs and boughtIs match a trade's sold and bought asset respectively
+	soldIs := `(htrd.sold_asset_type = ?
+		AND htrd.sold_asset_code = ?
+		AND htrd.sold_asset_issuer = ?)`
+	boughtIs := `(htrd.bought_asset_type = ?
+		AND htrd.bought_asset_code = ?
+		AND htrd.bought_asset_issuer = ?)`
+
+	result.trades = sq.Select(
+		"htrd.history_operation_id",
+		"htrd.order",
+	).
+		Column(
+			fmt.Sprintf("(%s / ?) * ? AS bucket", closedAtMillis),
+			resolution, resolution,
+		).
+		Column(
+			fmt.Sprintf(
+				"CASE WHEN %s THEN htrd.sold_amount ELSE htrd.bought_amount END AS base_amount",
+				soldIs,
+			),
+			bt, bc, bi,
+		).
+		Column(
+			fmt.Sprintf(
+				"CASE WHEN %s THEN htrd.bought_amount ELSE htrd.sold_amount END AS counter_amount",
+				soldIs,
+			),
+			bt, bc, bi,
+		).
+		Column(
+			fmt.Sprintf(
+				`CASE WHEN %s
+					THEN htrd.bought_amount::numeric / htrd.sold_amount
+					ELSE htrd.sold_amount::numeric / htrd.bought_amount
+				END AS price`,
+				soldIs,
+			),
+			bt, bc, bi,
+		).
+		From("history_trades htrd").
+		Join("history_ledgers hl ON hl.sequence = (htrd.history_operation_id >> 32)").
+		Where(
+			fmt.Sprintf("((%s AND %s) OR (%s AND %s))", soldIs, boughtIs, soldIs, boughtIs),
+			bt, bc, bi, ct, cc, ci,
+			ct, cc, ci, bt, bc, bi,
+		)
+
+	return result
+}
+
+// ForTimeRange filters the aggregation to only include trades from ledgers
+// closed at or after `start` and before `end`, both expressed in milliseconds
+// since the unix epoch.  A zero value leaves that side of the range open.
+func (q *TradeAggregationsQ) ForTimeRange(start, end int64) *TradeAggregationsQ {
+	if q.Err != nil {
+		return q
+	}
+
+	if start > 0 {
+		q.trades = q.trades.Where(closedAtMillis+" >= ?", start)
+	}
+
+	if end > 0 {
+		q.trades = q.trades.Where(closedAtMillis+" < ?", end)
+	}
+
+	return q
+}
+
+// Page specifies the paging constraints for the query being built by `q`.
+// The cursor is the timestamp of a bucket.
+func (q *TradeAggregationsQ) Page(page db2.PageQuery) *TradeAggregationsQ {
+	if q.Err != nil {
+		return q
+	}
+
+	q.sql, q.Err = page.ApplyTo(q.sql, "htrd.bucket")
+	return q
+}
+
+// Select loads the results of the query specified by `q` into `dest`.
+func (q *TradeAggregationsQ) Select(dest interface{}) error {
+	if q.Err != nil {
+		return q.Err
+	}
+
+	q.Err = q.parent.Select(dest, q.sql.FromSelect(q.trades, "htrd"))
+	return q.Err
+}
+
+var selectTradeAggregation = sq.Select(
+	"htrd.bucket AS timestamp",
+	"count(*) AS count",
+	"sum(htrd.base_amount) AS base_volume",
+	"sum(htrd.counter_amount) AS counter_volume",
+	"round(sum(htrd.counter_amount) / sum(htrd.base_amount), 7) AS avg",
+	"round(max(htrd.price), 7) AS high",
+	"round(min(htrd.price), 7) AS low",
+	"round((array_agg(htrd.price ORDER BY htrd.history_operation_id asc, htrd.order asc))[1], 7) AS open",
+	"round((array_agg(htrd.price ORDER BY htrd.history_operation_id desc, htrd.order desc))[1], 7) AS close",
+).GroupBy("htrd.bucket")
@@ -4,6 +4,7 @@ import (
 	"testing"
 
 	"github.com/stellar/go/build"
+	"github.com/stellar/go/xdr"
 	"github.com/stellar/horizon/db2"
 	"github.com/stellar/horizon/test"
 )
@@ -46,6 +47,33 @@ func TestTradeQueries(t *testing.T) {
 		tt.Assert.Len(trades, 0)
 	}
 
+	// Test OfferTradesByID() and LastOfferTrade()
+	err = q.Trades().ForOffer(2).Page(db2.MustPageQuery("", "asc", 10)).Select(&trades)
+	tt.Require.NoError(err)
+
+	var totals OfferTrades
+	err = q.OfferTradesByID(&totals, 2)
+	if tt.Assert.NoError(err) {
+		tt.Assert.Equal(int64(2), totals.Count)
+		tt.Assert.Equal(trades[0].SoldAmount+trades[1].SoldAmount, totals.SoldAmount)
+		tt.Assert.Equal(trades[0].BoughtAmount+trades[1].BoughtAmount, totals.BoughtAmount)
+		tt.Assert.Equal(trades[1].HistoryOperationID, totals.LastOperationID)
+	}
+
+	var last Trade
+	err = q.LastOfferTrade(&last, 2)
+	if tt.Assert.NoError(err) {
+		tt.Assert.Equal(trades[1], last)
+	}
+
+	err = q.OfferTradesByID(&totals, 4)
+	if tt.Assert.NoError(err) {
+		tt.Assert.Equal(OfferTrades{}, totals)
+	}
+
+	err = q.LastOfferTrade(&last, 4)
+	tt.Assert.True(q.NoRows(err))
+
 	// Test ForSoldAsset()
 
 	q.Trades().ForSoldAsset(build.NativeAsset().MustXDR()).Select(&trades)
@@ -73,3 +101,69 @@ func TestTradeQueries(t *testing.T) {
 		tt.Assert.Equal("USD", trades[0].BoughtAssetCode)
 	}
 }
+
+func TestTradeAggregationQueries(t *testing.T) {
+	tt := test.Start(t).Scenario("kahuna")
+	defer tt.Finish()
+	q := &Q{tt.HorizonSession()}
+
+	native := build.NativeAsset().MustXDR()
+	usd := build.CreditAsset("USD", "GAXMF43TGZHW3QN3REOUA2U5PW5BTARXGGYJ3JIFHW3YT6QRKRL3CPPU").MustXDR()
+
+	var sold, bought []Trade
+	tt.Require.NoError(q.Trades().ForSoldAsset(native).ForBoughtAsset(usd).Select(&sold))
+	tt.Require.NoError(q.Trades().ForSoldAsset(usd).ForBoughtAsset(native).Select(&bought))
+
+	var expectedCount int64
+	var expectedVolume xdr.Int64
+	for _, trade := range sold {
+		expectedCount++
+		expectedVolume += trade.SoldAmount
+	}
+	for _, trade := range bought {
+		expectedCount++
+		expectedVolume += trade.BoughtAmount
+	}
+
+	// a single bucket spanning all of history sees every trade, in both
+	// directions
+	var aggs []TradeAggregation
+	pq := db2.MustPageQuery("", "asc", 10)
+	err := q.TradeAggregations(native, usd, 1<<40).Page(pq).Select(&aggs)
+	if tt.Assert.NoError(err) && tt.Assert.Len(aggs, 1) {
+		tt.Assert.Equal(expectedCount, aggs[0].TradeCount)
+		tt.Assert.Equal(expectedVolume, aggs[0].BaseVolume)
+	}
+
+	// swapping base and counter swaps the volumes
+	var swapped []TradeAggregation
+	err = q.TradeAggregations(usd, native, 1<<40).Page(pq).Select(&swapped)
+	if tt.Assert.NoError(err) && tt.Assert.Len(swapped, 1) {
+		tt.Assert.Equal(aggs[0].BaseVolume, swapped[0].CounterVolume)
+		tt.Assert.Equal(aggs[0].CounterVolume, swapped[0].BaseVolume)
+	}
+
+	// buckets are returned in order and partition the trades
+	err = q.TradeAggregations(native, usd, 1000).Page(pq).Select(&aggs)
+	if tt.Assert.NoError(err) {
+		var count int64
+		for i, agg := range aggs {
+			count += agg.TradeCount
+			tt.Assert.Equal(int64(0), agg.Timestamp%1000)
+			if i > 0 {
+				tt.Assert.True(agg.Timestamp > aggs[i-1].Timestamp)
+			}
+		}
+		tt.Assert.Equal(expectedCount, count)
+	}
+
+	// time range filtering
+	err = q.TradeAggregations(native, usd, 1000).ForTimeRange(0, 1000).Page(pq).Select(&aggs)
+	if tt.Assert.NoError(err) {
+		tt.Assert.Len(aggs, 0)
+	}
+
+	// invalid resolution
+	err = q.TradeAggregations(native, usd, 0).Page(pq).Select(&aggs)
+	tt.Assert.Error(err)
+}
@@ -61,6 +61,13 @@ func (q *TransactionsQ) ForLedger(seq int32) *TransactionsQ {
 	return q
 }
 
+// IncludeFailed changes the query to include failed transactions.  By default,
+// only successful transactions are loaded.
+func (q *TransactionsQ) IncludeFailed() *TransactionsQ {
+	q.includeFailed = true
+	return q
+}
+
 // Page specifies the paging constraints for the query being built by `q`.
 func (q *TransactionsQ) Page(page db2.PageQuery) *TransactionsQ {
 	if q.Err != nil {
@@ -77,6 +84,10 @@ func (q *TransactionsQ) Select(dest interface{}) error {
 		return q.Err
 	}
 
+	if !q.includeFailed {
+		q.sql = q.sql.Where("(ht.successful = true OR ht.successful IS NULL)")
+	}
+
 	q.Err = q.parent.Select(dest, q.sql)
 	return q.Err
 }
@@ -101,6 +112,7 @@ var selectTransaction = sq.Select(
 		"ht.memo, " +
 		"lower(ht.time_bounds) AS valid_after, " +
 		"upper(ht.time_bounds) AS valid_before, " +
+		"COALESCE(ht.successful, true) AS successful, " +
 		"hl.closed_at AS ledger_close_time").
 	From("history_transactions ht").
 	LeftJoin("history_ledgers hl ON ht.ledger_sequence = hl.sequence")
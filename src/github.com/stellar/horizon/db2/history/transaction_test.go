@@ -21,4 +21,34 @@ func TestTransactionQueries(t *testing.T) {
 	fake := "not_real"
 	err = q.TransactionByHash(&tx, fake)
 	tt.Assert.Equal(err, sql.ErrNoRows)
+
+	// Failed transactions are excluded unless requested
+	_, err = q.ExecRaw(
+		"UPDATE history_transactions SET successful = false WHERE transaction_hash = ?",
+		real,
+	)
+	tt.Require.NoError(err)
+
+	var txs []Transaction
+	err = q.Transactions().Select(&txs)
+	if tt.Assert.NoError(err) {
+		for _, tx := range txs {
+			tt.Assert.NotEqual(real, tx.TransactionHash)
+		}
+	}
+
+	txs = []Transaction{}
+	err = q.Transactions().IncludeFailed().Select(&txs)
+	if tt.Assert.NoError(err) {
+		found := false
+		for _, tx := range txs {
+			if tx.TransactionHash == real {
+				found = true
+				tt.Assert.False(tx.Successful)
+			} else {
+				tt.Assert.True(tx.Successful)
+			}
+		}
+		tt.Assert.True(found)
+	}
 }
@@ -1,11 +1,16 @@
 // Code generated by go-bindata.
 // sources:
 // latest.sql
+// migrations/10_index_effects_by_offer_id.sql
 // migrations/1_initial_schema.sql
 // migrations/2_index_participants_by_toid.sql
 // migrations/3_use_sequence_in_history_accounts.sql
 // migrations/4_add_protocol_version.sql
 // migrations/5_create_trades_table.sql
+// migrations/6_add_transaction_successful.sql
+// migrations/7_create_reingest_progress.sql
+// migrations/8_create_asset_stats.sql
+// migrations/9_create_outbox.sql
 // DO NOT EDIT!
 
 package schema
@@ -73,7 +78,7 @@ func (fi bindataFileInfo) Sys() interface{} {
 	return nil
 }
 
-var _latestSql = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\xc4\x5b\x5b\x6f\xdb\xb8\xd6\x7d\xcf\xaf\x20\xe6\xc5\x36\x60\x07\x71\x5a\xe7\xe2\x20\x05\x5c\x47\xf3\xd5\x18\x57\x99\xc6\xca\xd7\x29\x06\x03\x82\x96\x68\x9b\xa7\x92\xa8\x92\x74\x1a\xcf\xc1\xf9\xef\x07\xba\xd9\xba\x90\xa2\x64\x2b\x73\x1e\x63\x6e\x2e\xae\xc5\x4d\x71\x5f\xa4\x0c\x06\x67\x83\x01\xf8\x9d\x72\xb1\x66\x78\xf1\x65\x0e\x1c\x24\xd0\x12\x71\x0c\x9c\xad\x17\x9c\x0d\x06\x67\xe1\xf8\xc3\xd6\x0b\xb0\x03\x56\x8c\x7a\x07\x83\x17\xcc\x38\xa1\x3e\xb8\x3d\xbf\x3a\xbf\xcc\x58\x2d\x77\x20\x58\xc3\x70\x7a\xc1\xe4\x6c\x61\x58\x80\x0b\x24\xb0\x87\x7d\x01\x05\xf1\x30\xdd\x0a\x70\x0f\x2e\xee\xa2\x21\x97\xda\xdf\xcb\xbf\xda\x2e\x09\xad\xb1\x6f\x53\x87\xf8\x6b\x70\x0f\x3a\xcf\xd6\xaf\x37\x9d\xbb\x14\xce\x77\x10\x73\xa0\x4d\xfd\x15\x65\x1e\xf1\xd7\x90\x0b\x46\xfc\x35\x07\xf7\x80\xfa\x09\xc6\x06\xdb\xdf\xe1\x6a\xeb\xdb\x82\x50\x1f\x2e\xa9\x43\x70\x38\xbe\x42\x2e\xc7\xb9\x65\x3c\xe2\x43\x0f\x73\x8e\xd6\x91\xc1\x4f\xc4\x7c\xe2\xaf\xef\x12\xee\x18\x31\x7b\x03\x03\x24\x36\xe0\x1e\x04\xdb\xa5\x4b\xec\x7e\x28\xd6\x46\x02\xb9\x34\x35\x73\xf0\x0a\x6d\x5d\x01\x05\x5a\xba\x98\x07\xc8\xc6\x21\xe9\x4e\x61\xf4\x27\x11\x1b\x48\x89\x93\xe1\x71\x16\x7b\xc3\x44\x1e\x1e\x83\x35\x65\x01\xf4\xc8\x9a\xa1\x90\x33\xbf\x03\xd6\x2e\xc0\x63\x60\x4d\x3e\xce\x8d\x3b\xb0\xb0\x37\xd8\x43\xe3\x84\xc4\x1d\x78\xfc\xe9\x63\x36\x06\x83\xc8\x63\xd3\x27\x63\x62\x19\xb1\x69\x11\x07\x74\xcf\x00\x00\x80\x38\x40\xe0\x57\x01\xcc\x47\x0b\x98\xcf\xf3\x79\x3f\xfa\x15\x05\x81\x4b\xb0\x03\x91\x00\xa1\x1f\xb8\x40\x5e\x00\x42\xa2\xd1\x9f\xe0\x6f\xea\xe3\xb3\xde\xdd\x59\x9e\xe8\x86\x70\x41\xd9\x0e\x22\xdb\xa6\x5b\x5f\x70\x48\x1c\xc8\xf1\x8f\x94\xf0\xc2\xf8\xf2\x6c\x98\xd3\x9a\x9c\x53\x6b\x15\x6a\x44\x73\x61\x4d\x9e\x2c\xf0\x75\x66\x7d\x02\xc3\xe8\x87\x99\x39\x7d\x32\x3e\x1b\xa6\x05\x3e\x7e\x4b\x7e\x32\x1f\xc1\xe7\x99\xf9\xff\x93\xf9\xb3\xb1\xff\x7b\xf2\xc7\xe1\xef\xe9\x64\xfa\xc9\x00\x43\x9d\x98\xa3\xb7\xbd\x08\x74\xd8\xf7\x25\x59\x13\x5f\x80\x07\xe3\xd7\xc9\xf3\xdc\x02\x3e\x7e\x15\x2f\xc8\xed\x76\x14\x8a\x3b\xe3\x31\xc3\x6b\xdb\x45\x9c\xf7\x8a\xee\x72\x1c\x86\x39\x07\xf6\x06\x31\x64\x0b\xcc\xc0\x0b\x62\x3b\xe2\xaf\xbb\x57\xef\x7b\x6a\x47\xe1\xd5\x0a\xdb\x2d\x48\x4b\x70\x12\x65\x05\xfa\xf0\xa0\x34\x4f\x3a\xb5\xa3\x01\x8e\x8f\xa4\xd2\xf2\x17\xca\x1c\xcc\x7e\x01\xc4\x17\x78\x8d\x59\x61\x54\xec\x02\xac\x18\x72\xb0\x40\xc4\xe5\xe0\x5f\x9c\xfa\x4b\xf5\x3e\xb8\xd8\x59\x63\x76\xfa\x3e\x24\x38\xc9\x3e\x70\xfc\x63\x8b\x7d\x5b\xc5\x2d\x36\x86\x1b\xc4\x37\x72\xbf\x15\xec\x03\x86\x5f\x08\xdd\x72\xa8\x9d\x98\x6c\x0b\x43\x3e\x47\xf1\x3d\x17\x39\x62\xcf\x23\x3d\x70\x17\x85\x15\x0e\x8e\xa8\x67\x6f\xbb\x94\xcb\xee\x88\xf0\xd6\xde\x5f\x13\xc5\x39\x0c\x23\xa1\x9d\x14\xdb\x6e\x03\xa7\xb6\xed\xfe\xe8\x24\x7f\x7a\x01\x65\x02\x33\x98\x06\x9e\xa2\x96\x61\xf1\x10\x51\x81\x5c\x68\x53\xe2\x73\xf9\x19\x5c\x61\x0c\x03\x4a\x5d\xf9\x68\x18\x07\xe1\x0a\xab\x7c\x1d\x0d\x33\xcc\x31\x7b\x51\x99\x78\xe8\x15\x8a\x57\xc8\xb1\x80\x9c\xfc\xad\xb2\x0a\x18\x15\xd4\xa6\xae\x52\xd7\xc1\x47\xea\xe3\x7e\xf0\x73\x80\x98\x20\x36\x09\x50\x1b\x17\x9c\x1c\xf6\x70\xdd\xc9\x15\xd5\xbf\x05\xf4\xf7\x4a\x53\xc9\xed\x06\xa8\xca\x35\xfe\xa9\x70\xd5\x48\x28\x78\xfc\x6a\x1a\x0f\xe0\xe3\x37\x8d\xe2\xc9\xdc\x32\x9e\x1a\x0a\xde\x63\x6b\xcc\xcf\x89\xa3\xd5\xd2\xe2\xd9\x2c\x87\xdf\xc2\x3d\x90\xb9\x35\x55\x36\x51\x72\x64\xc7\x52\xa2\xc8\x74\x62\x60\x8a\x7f\xe2\x74\xcb\x6c\x9c\x9e\x6e\x45\x48\x48\x1f\xf3\x4e\x67\x3c\x2e\x59\xd4\x78\x0e\x04\x43\x0e\x3e\x7d\x3b\x63\x98\x42\xbc\x3f\x35\x8e\xd3\xd5\x0a\x33\xe5\x5c\x8e\x5d\xb7\x62\x78\xb9\xdd\x55\x4d\xa6\xae\x03\x11\x0f\x2f\xd7\xc8\x29\x75\xe2\x6d\x66\x0e\xe1\x7c\x8b\x99\x64\xd6\xe8\xaa\x62\x96\x4d\x1d\xd9\x4a\xc3\x4b\xf9\x1c\x2f\x72\xbb\x5c\x1c\xdd\xae\x37\xa2\xa9\x80\xdc\xac\x06\x12\x72\xf3\x6a\x8b\x48\x67\x55\xc8\x98\x3e\x9a\x0b\xeb\x69\x32\x33\xad\xc2\x41\x82\xb9\xc9\x30\x2a\xd2\xc0\xf4\x93\x31\xfd\x0d\x74\xbb\x79\xe0\x0f\xe0\xa2\xd7\xd3\xc1\x65\x36\xb4\x00\x96\xdd\xea\x08\xaa\xf2\x51\xd9\xdf\x04\xad\xc6\x49\x15\x70\xdd\x48\x59\xe7\x8a\x3a\x25\x56\xaa\xf8\xb5\x1b\x2d\x35\xab\xfc\x53\xf1\xb2\xa1\xd8\x13\x23\xa6\x66\xb5\x72\xcc\x54\x4d\xa8\x88\x9a\x99\x29\xad\x9e\xd5\xf4\x7c\x66\x29\xd5\x2e\x5e\x92\x9a\x45\x53\x12\xd5\x0d\xac\xd5\x31\x52\x6a\x7b\x58\x5a\x9d\xdd\x23\xe5\xa3\xa7\xaa\x8c\xfe\x27\xb5\x8d\x78\x85\xd8\x7f\xc1\x2e\x0d\xb0\xac\x75\x23\x5e\xc3\x4a\x63\xeb\x0a\xc5\xa0\x87\x05\x52\x0c\x85\xbb\xa0\x1a\xe6\x64\xed\x23\xb1\x65\x58\xd6\x65\xb8\xbd\xea\xfd\xf9\xd7\x21\x39\xf9\xf7\x7f\x64\xe9\xc9\x9f\x7f\x15\x4b\x1e\xec\x51\x45\x38\x3b\x60\xf9\xd4\xc7\x95\xc9\xce\x01\xab\x0c\x93\x28\x23\x1e\x0e\x43\x8c\xef\xf0\xd0\x73\x37\x0c\xf9\xeb\x8a\xf6\x95\xa2\x8e\x21\x4e\xfa\x34\x25\xdc\x6a\x5d\x01\xf1\xe3\xf4\x68\xce\x75\x79\x30\x88\xed\xa7\x8f\xf3\xe7\xcf\x66\xe8\xf2\x85\x61\xa9\x5b\x43\x95\xa9\x77\xb6\x51\xd4\xf4\xde\x6b\x4f\xa6\x72\x85\x46\x42\x35\x37\xa6\x5c\xea\x03\x12\x08\xac\x28\xab\xd1\x40\x05\x0f\x13\x6b\xa2\x91\x38\x33\x17\xc6\x93\x05\x66\xa6\xf5\x58\x6a\xa2\x46\x81\x66\x01\xba\x9d\x21\x24\x3e\x11\x04\xb9\x90\x47\x58\xe7\xfc\x87\xdb\xe9\x83\xce\xe5\xc5\xf0\x7a\x70\x71\x3d\xb8\xbc\x02\xc3\xd1\x78\x74\x33\xbe\x1c\x9d\xbf\xbb\xba\xba\x1a\xdd\x0c\x2e\x46\x9d\xde\x5d\x3d\xf4\x4b\x48\x7c\x07\xbf\xe6\xb7\x60\xb9\x83\x82\x12\xa7\x7a\xa5\xdb\xd1\xd5\x6d\x93\x95\xde\xc1\x2d\xc7\xfb\xdb\x12\x12\x1f\x16\xdb\x91\x95\xeb\x5d\x0f\xaf\xaf\xdf\x37\x59\xef\x3d\x44\x8e\x03\x8b\x7d\x8d\xea\x35\xae\x2f\x46\x8d\x34\x8d\x60\x7c\x35\xa7\xf9\x61\xd4\x8f\xaf\x5c\xe2\x66\x38\xba\x4d\x65\x28\x4e\x55\x65\x83\xb8\xce\xb1\x3a\xaa\x79\x1e\x3e\x2d\x1a\xdc\x85\x31\x37\xa6\x56\xe6\x6d\xc4\x39\xc7\xd5\x8d\xe5\x3e\x18\xf6\xe3\x57\x0f\x7a\xb9\xb2\x9e\x71\x13\xb5\x0a\x58\x59\x0b\xb6\x05\xd8\x1a\xad\xae\xe3\x5d\xd5\xac\xd7\xd2\x86\xe3\xaa\xaf\xfd\x26\x6e\x54\xf4\x56\x5a\xd8\x72\x49\x8b\xa1\x1d\x54\x7d\x35\x76\xbc\x2b\x9b\x96\x01\x6d\x38\x53\x17\xda\x9a\xb8\x53\x99\xf4\x37\xdf\x92\xe2\x2d\x5a\xf8\x1b\x06\xdf\xf1\x2e\x5d\xe2\x50\x82\x37\xcd\x12\x0a\xa8\x51\x9e\x36\x79\x78\xc8\x16\xf5\xb2\x85\xc1\xef\x4f\xb3\xcf\x93\xa7\x6f\xe0\x37\xe3\x1b\xe8\x12\xa7\x69\x12\xa7\x79\x90\xda\xd1\x56\xbd\x88\x4c\x6a\x0d\x5a\xb5\x95\x2b\xf3\x2e\xed\xb9\x6b\x57\xbd\x6a\x99\x2a\xfd\x95\xd4\xb4\x3b\xb0\xdc\x47\xb6\x54\xc5\xcc\x7c\x30\xfe\xa8\x57\xf7\x46\xa6\x19\x08\xf0\x68\xca\xab\xe0\xe7\xc5\xcc\xfc\x3f\xb0\x14\x0c\x63\xd0\x4d\x8c\xfb\xa5\x32\x53\x46\x2e\xac\x96\x4f\x61\x16\x55\xdb\xb5\x68\x15\x6b\x74\x19\x9b\x38\xe2\x9e\xc2\x27\x46\xa8\xc7\xa8\xd0\x00\xe8\x97\x6b\x7d\xe9\x81\x86\x38\xcc\x72\xa3\xf1\x23\x98\x3e\x9b\xb3\x2f\xcf\x29\xe1\x02\x5c\x96\x76\xfa\xfe\x3a\xc7\x58\xd6\xd6\xee\xa7\x2d\x6c\x15\xd9\x43\xf5\x74\x22\x4d\xe2\xd4\x26\x78\xe8\xf1\xf5\xa5\xbd\x78\x0d\x69\x1a\xc0\xa0\x2d\xde\x09\x56\x96\xba\xe2\x22\x3e\x4a\x89\x5c\x80\x78\x6d\x4f\x40\x82\xa5\x38\xd3\x47\x4a\xc8\x37\x6c\xcb\x22\x68\x10\x9e\xca\x0d\x3d\x4a\x43\x42\xfe\x80\x71\xec\xe6\x57\x6f\xf4\xfe\xb3\x83\xe5\xae\x8d\xbd\xce\xc3\x65\x29\xa7\xdf\x50\xe4\x38\xca\x19\x65\xf7\xb5\x2d\x5a\x25\xcc\x7a\xd7\x9b\x8c\xa0\x88\x5d\x22\x4e\x71\xeb\x01\xe3\xf8\x23\xa9\x3b\x7e\x22\xf2\x42\xfc\x9a\xe5\x04\xa6\x19\x94\x02\x57\x07\x17\x98\x95\xde\x67\xf5\xcb\x2f\x9d\xfa\xb2\xf7\x57\x2a\xf2\x9c\xba\xa7\x6c\xf2\x1e\x43\x47\xbc\xf0\x1e\xb1\x5f\x7c\xdd\xd7\x2f\xbf\x35\x94\x51\x76\xa2\x28\xb4\x5a\x9d\x10\x7e\x73\x28\x3a\xda\xe9\x9b\x55\x39\x97\xa0\x85\x07\x27\xc1\xd1\x11\x69\x16\x9e\xe2\x96\x57\xa9\x69\x41\x7d\x98\x7c\xef\x76\x2a\x6d\xed\x02\x59\x3d\xfb\xef\xf7\xf2\x09\x60\x6c\xd8\x80\xfb\xe9\xbb\x5d\x85\xad\x67\x2c\x39\x06\x79\xc0\x24\xd9\x08\xf1\xc2\x43\x7e\xf4\x11\xad\x44\xd5\x66\x37\xa1\x91\x86\x68\x12\x2a\x42\xc8\xfd\xa7\x68\x2d\xb1\x95\x41\x6b\xa3\xd4\xde\xb2\x3e\xef\xb6\x0f\x43\x0e\xfa\x98\xb0\xaa\x86\x2b\x7c\x51\xd7\xfe\x46\x97\xbe\xd9\xd3\xd2\x2f\x4c\xa8\x2f\x26\xf3\x09\xe5\x9b\xed\x7f\xf6\x33\x4d\x9d\x92\x8c\x6d\x7d\x11\xb2\x0f\x42\xdf\x4c\x8d\xf4\xeb\x53\x9d\x2c\xd9\xa4\xfa\xfa\xd2\x5a\xf1\xcd\x34\xed\x5f\x09\xeb\x74\x28\x8b\xfa\x3c\xf4\xa1\xa7\xfa\x16\x8f\x76\x11\x5d\x9a\xe7\x37\x7d\xc0\xf3\xa0\xf9\x4c\xb1\xa5\x27\xbc\x6a\x89\x3a\x1a\x34\xe9\x6b\xe5\x62\xed\x85\xaf\x32\x70\x2d\xee\xfa\x20\x96\xad\x29\xde\xe2\xd8\x94\xf1\x8f\xae\x68\xa2\x8c\x6e\x1f\xc8\xd3\x46\x0a\x5c\x52\xfa\xfd\xe8\x5d\xae\xc0\xd4\xa6\x08\xdd\x6e\xfa\x19\xe5\xe0\xc3\x07\xd0\x29\x24\xe7\x9d\xf1\x58\xe0\x57\xd1\xeb\xf5\x81\xda\x30\x4c\xda\x6b\x19\xc6\xc9\xbc\xda\xb4\x54\xd2\xd4\x34\xad\x26\x20\x29\x81\xf6\xc6\x3d\xf0\xf5\x93\xf1\x64\xc4\x87\x0c\xdc\x83\x77\xef\x32\x0e\x53\xfd\x1f\x17\xb0\xa9\x17\xb8\x58\xe0\xc8\x13\xff\x0d\x00\x00\xff\xff\x45\x14\xa8\x0a\xf4\x35\x00\x00")
+var _latestSql = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\xc4\x5c\x5d\x6f\xe3\xb6\xd2\xbe\xcf\xaf\x20\x7a\x63\x1b\xb0\x83\x38\xdb\x7c\x39\xd8\x02\xde\xc4\x7d\x37\x68\xd6\xe9\x26\xce\xbb\x5d\x14\x05\x41\x4b\xb4\xcd\xb3\x92\xa8\x25\xa9\xac\xd3\x83\xf3\xdf\x0f\xa8\x0f\x5b\xa2\x48\x51\x92\x95\x9e\x4b\x8b\xc3\x67\x9e\x19\x92\x33\xe4\x90\xc9\x68\x74\x34\x1a\x81\xdf\x29\x17\x6b\x86\x9f\x3e\xdf\x03\x17\x09\xb4\x44\x1c\x03\x37\xf2\xc3\xa3\xd1\xe8\x48\xb6\xdf\x46\x7e\x88\x5d\xb0\x62\xd4\xdf\x0b\xbc\x60\xc6\x09\x0d\xc0\xd5\xf1\xf9\xf1\x69\x4e\x6a\xf9\x0a\xc2\x35\x94\xdd\x15\x91\xa3\xa7\xd9\x02\x70\x81\x04\xf6\x71\x20\xa0\x20\x3e\xa6\x91\x00\xef\xc1\xc9\x75\xdc\xe4\x51\xe7\x5b\xf9\xab\xe3\x11\x29\x8d\x03\x87\xba\x24\x58\x83\xf7\xa0\xf7\xbc\xf8\xf5\xb2\x77\x9d\xc1\x05\x2e\x62\x2e\x74\x68\xb0\xa2\xcc\x27\xc1\x1a\x72\xc1\x48\xb0\xe6\xe0\x3d\xa0\x41\x8a\xb1\xc1\xce\x37\xb8\x8a\x02\x47\x10\x1a\xc0\x25\x75\x09\x96\xed\x2b\xe4\x71\x5c\x50\xe3\x93\x00\xfa\x98\x73\xb4\x8e\x05\x7e\x20\x16\x90\x60\x7d\x9d\x72\xc7\x88\x39\x1b\x18\x22\xb1\x01\xef\x41\x18\x2d\x3d\xe2\x0c\xa5\xb1\x0e\x12\xc8\xa3\x99\x98\x8b\x57\x28\xf2\x04\x14\x68\xe9\x61\x1e\x22\x07\x4b\xd2\x3d\xa5\xf5\x07\x11\x1b\x48\x89\x9b\xe3\x71\x94\x8c\xc6\x1c\xf9\x78\x02\x10\xe7\x58\x40\xe9\x2e\x0e\x89\x0b\x39\xfe\x7e\x0d\x16\xaf\x21\x9e\x80\xa7\xd9\xe7\xe7\xd9\xfc\x66\x76\x0d\x9e\x9c\x0d\xf6\xd1\x24\xa5\x72\x0d\x1e\x7e\x04\x98\x4d\xc0\x28\x1e\xb7\x9b\xc7\xd9\x74\x31\xdb\x49\x6b\x00\x8f\x00\x00\xe0\x69\x31\x7d\x5c\x80\x2f\x77\x8b\x8f\x60\x1c\x7f\xb8\x9b\xdf\x3c\xce\x3e\xcd\xe6\x0b\xf0\xe1\x6b\xfa\x69\xfe\x00\x3e\xdd\xcd\xff\x7f\x7a\xff\x3c\xdb\xfd\x9e\xfe\xb1\xff\x7d\x33\xbd\xf9\x38\x03\xe3\xeb\x23\xa3\x09\x19\xf7\xc5\xf4\xc3\x7d\x4d\xe2\xb1\x68\x1e\x03\xf4\x63\x65\xc4\x05\x4b\xb2\x26\x81\x00\xb7\xb3\x5f\xa7\xcf\xf7\x0b\x10\xe0\xad\x78\x41\x5e\xbf\x57\x36\xb1\x37\x99\x30\xbc\x76\x3c\xc4\xf9\x00\xcc\x1f\x16\x60\xfe\x7c\x7f\x3f\x8c\x71\x12\x61\xf1\x1a\x62\xe0\x6c\x10\x43\x8e\xc0\x0c\xbc\x20\xf6\x4a\x82\x75\xff\xfc\x67\xbd\xb8\x43\x5d\x9d\xf8\xf8\x54\x2f\x4e\x38\x8f\x30\xd3\x74\x38\x3b\x2f\x75\xf0\x69\x14\x88\xcc\xb4\x62\x5b\x10\xf9\x10\x39\x8e\x14\xe0\x80\x04\x02\xaf\x31\x53\x44\x56\x1e\x5a\x97\xdb\x8e\x06\xea\x98\xe0\x6d\x48\x99\x80\x4e\xc4\x38\x65\xed\x87\xa5\x08\x93\x8e\x4c\x80\xfc\x7a\xbe\xf4\x10\x17\x10\xbf\xc8\x05\xb7\x1f\xcd\xa2\x48\x14\xba\x48\x60\x17\x22\x01\x64\x48\xe0\x02\xf9\x21\x90\x6b\x86\x46\xc9\x17\xf0\x37\x0d\xb0\x0e\x57\x6c\xcb\x98\x65\x3f\xac\x29\x0b\xa1\x4f\xd6\x0c\xc9\x90\xd0\xde\x11\x0a\xce\x7e\x8e\x0a\xbc\x55\x6d\x42\x61\xe8\x11\x9d\x4d\x7b\x83\xca\x44\x37\x84\x0b\xca\x5e\x77\xe3\xdf\x4d\x30\x30\xa0\xbe\x75\x44\x50\xd5\xb6\x76\xbb\x0a\x64\x8f\x0d\x06\x8b\xab\x02\x84\xeb\x32\xcc\xb9\x7e\x46\x9b\x07\x0a\xaf\x56\xd8\xe9\xc0\xb4\x14\x27\xb5\x4c\xa1\x6f\x5c\x37\x99\x1c\x0d\x71\x32\x25\x8d\x92\x3f\x51\xe6\x62\xf6\x93\x21\x9e\xc4\x71\x51\xdf\xe4\x62\x81\x88\xc7\xc1\xbf\x38\x0d\x96\x66\x3f\x78\xd8\x5d\x63\x76\xb8\x1f\x52\x9c\xd4\x0f\x1c\x7f\x8f\x70\xe0\x98\xb8\x25\xc2\x70\x83\xf8\xa6\x56\x24\x0a\x19\x7e\x21\x34\xe2\xd0\xda\x31\x75\x0b\x43\x01\x47\xc9\x36\x22\x1e\x88\x1d\x8f\x6c\xc2\x9d\x28\x1a\xf6\x03\x51\x4f\xde\xf1\x28\x6f\x1a\xf7\x1c\x86\x6b\x04\xcb\x26\x81\x75\x58\x5c\x4e\xe9\x4f\x5f\x06\x7d\xcc\x60\xb6\xaf\x53\x6d\x19\xab\x93\x88\x0a\xe4\x41\x87\x92\x80\xeb\xe7\xe0\x0a\x63\x18\x52\xea\xe9\x5b\xe5\x36\x13\xae\xb0\x69\xac\xe3\x66\x86\x39\x66\x2f\x26\x11\x1f\x6d\xa1\xd8\xc2\x78\x57\x40\xfe\x36\x49\x85\x8c\x0a\xea\x50\xcf\x68\xd7\x49\x45\x22\x29\x2f\xb8\x10\x31\x41\x1c\x12\xa2\x2e\x02\x9c\x1e\x76\x1f\xee\xf4\x16\xd5\x8f\x02\xf6\xb8\xd2\xd4\xe4\x6e\x13\x54\xa5\x8e\x7f\x2a\x5d\x35\x32\x14\x3c\x7c\x99\xcf\x6e\xc1\x87\xaf\x16\x8b\xa7\xf7\x8b\xd9\x63\x43\x83\x77\xd8\x16\xf1\x63\xe2\x5a\x6d\xe9\x70\x6e\x96\xd3\xaf\x12\x07\x72\x51\xd3\x24\x13\x6f\x8e\x9c\xc4\x94\x38\x33\x1d\x98\x98\x92\x4f\x9c\x46\xcc\xc1\xd9\xec\x36\xa4\x84\x6c\x99\xf7\x7a\x93\x49\x49\xa2\xc6\x3a\x10\x0c\xb9\xf8\x70\x77\x26\x30\x4a\xbe\x3f\x34\x8f\xd3\xd5\x0a\x33\x63\x5f\x8e\x3d\xaf\xa2\x79\x19\xbd\x56\x75\xa6\x9e\x0b\x1b\x9e\xa2\x72\x7d\x1a\x9c\x8d\x72\xbd\x6a\x1f\xc0\x92\x3e\x15\x87\xaa\x25\x8d\xd6\x1b\xd1\xd4\x80\x42\xaf\x06\x26\x14\xfa\xd5\x36\x22\xeb\x55\x61\xc6\xcd\xc3\xfc\x69\xf1\x38\xbd\x9b\x2f\x94\x89\x04\x0b\x9d\x61\x5c\x03\x01\x37\x1f\x67\x37\xbf\x81\x7e\xbf\x08\xfc\x0b\x38\x19\x0c\x6c\x70\x39\x87\x2a\x60\xb9\x96\x04\xaa\x72\xa9\xec\x22\x41\xa7\x79\xd2\x04\x5c\x37\x53\xd6\x09\x51\x87\xe4\x4a\x13\xbf\x6e\xb3\xa5\x45\xcb\x3f\x95\x2f\x1b\x1a\x7b\x60\xc6\xb4\x68\x2b\xe7\x4c\x53\x87\x8a\xac\x99\xeb\xd2\xe9\x5c\xcd\xe6\x67\x9e\x52\xed\xc3\x4b\x7a\x66\xb1\x1c\x89\xea\x26\xd6\xea\x1c\xa9\x95\xdd\xab\x36\xef\xee\x91\x71\xe9\x99\x4e\x46\xff\x93\xb3\x8d\xd8\x42\x1c\xbc\x60\x8f\x86\x58\x57\xba\x11\x5b\x79\xd2\x88\x3c\x61\x68\xf4\xb1\x40\x86\x26\xe9\x05\x53\x33\x27\xeb\x00\x89\x88\x61\x5d\x95\xe1\xea\x7c\xf0\xe7\x5f\xfb\xcd\xc9\xbf\xff\xa3\xdb\x9e\xfc\xf9\x97\x7a\xe4\xc1\x3e\x35\xa4\xb3\x3d\x56\x40\x03\x5c\xb9\xd9\xd9\x63\x95\x61\x52\xcb\x88\x8f\x65\x8a\x09\xdc\xb8\xec\x78\xc9\x50\xb0\x4e\x5d\xcb\x23\xc7\xc1\x9c\xaf\x22\x0f\x2c\x29\xf5\x30\x0a\x9a\x9e\x21\x00\x71\xb3\x55\x96\x72\xae\x15\x1a\x92\x65\xf6\x30\xbf\xb7\xed\x8f\x41\x22\x7f\xf3\x70\xff\xfc\x69\x2e\xa7\x82\xac\xcc\x1b\x4b\x46\x95\x5b\xf2\x7c\x01\xa9\x69\x3c\xec\xce\x4c\xa3\x86\x46\x86\x5a\x22\x69\x95\xa9\x34\x12\x4b\xba\x4d\x8a\xba\x1d\x25\x35\x1d\xe4\x5b\x67\xb0\x82\xce\xd6\x81\xbe\x80\x62\x2f\x4d\xea\x0c\xad\xa8\x4b\x1a\xf6\xaa\xef\x4a\xbb\xc7\x6c\x58\x4d\xfb\x99\x7a\xf9\xa3\x70\xaa\x6a\x15\x9c\x4b\x31\xb1\xec\x0b\xf9\x4d\xde\x26\x30\x1c\x88\xbe\x6a\x07\xc3\x24\x58\x63\x2e\xb0\x9b\xc5\x93\x5d\xbf\xf8\xe6\xac\x62\xfb\x95\x75\x85\x21\xa3\x6b\x59\xcd\x6d\x3d\xa6\x25\xa4\xac\x20\x29\x10\x13\x69\xf9\xd0\xe0\x41\x1c\xb8\xd5\x02\xc6\xc2\x9a\xe2\x6d\xea\x87\x1e\x6e\xe0\xef\xbc\x3f\x6e\x91\x40\x60\x45\x59\x8d\xfb\x0f\x70\x3b\x5d\x4c\x2d\xbe\xb9\x9b\x3f\xcd\x1e\x17\xe0\x6e\xbe\x78\x50\xb1\x40\xbc\xca\x9e\x40\xbf\x37\x86\x24\x20\x82\x20\x0f\xf2\x18\xeb\x98\x7f\xf7\x7a\x43\xd0\x3b\x3d\x19\x5f\x8c\x4e\x2e\x46\xa7\xe7\x60\x7c\x36\x39\xbb\x9c\x9c\x9e\x1d\xbf\x3b\x3f\x3f\x3f\xbb\x1c\x9d\x9c\xf5\x06\xd7\xf5\xd0\x4f\x21\x09\x5c\xbc\x2d\x46\xaa\xe5\x2b\x14\x94\xb8\xd5\x9a\xae\xce\xce\xaf\x9a\x68\x7a\x07\x23\x8e\x77\xeb\x04\x92\x00\xaa\xb7\x09\x95\xfa\x2e\xc6\x17\x17\x3f\x37\xd1\xf7\x33\x44\xae\x0b\xd5\xb2\x64\xb5\x8e\x8b\x93\xb3\x46\x36\x9d\xc1\x64\xf1\x66\xc7\xbb\xf8\xb6\xba\x52\xc5\xe5\xf8\xec\xaa\x91\x19\xe7\xb1\x19\xf9\xa4\xb2\xdf\x19\x74\xab\xe9\x22\x33\xa6\xb4\x4a\xbb\xd5\x73\x99\xe9\xc9\xdd\x34\x77\xab\xe1\x2a\xd3\x90\x24\x85\x6e\xc1\xc7\x27\xe9\x92\x49\xef\x96\xe4\x6a\xc9\x0a\x44\xb5\x35\x19\x02\x4a\xe5\xd5\x5e\x9d\x88\xd2\xea\xda\x53\xee\x67\x2c\xb8\x4f\xb3\xfb\xd9\xcd\x22\xf7\x4c\xe3\x98\xe3\xea\x2b\xc1\x21\x18\x0f\x93\xcc\x62\x37\x57\x77\xdb\xd7\xc4\x5a\x03\xac\xee\xf2\xac\x03\xd8\x1a\x97\x14\xed\x87\xaa\x59\x95\xbc\x8b\x81\xab\xde\x98\x37\x19\x46\x43\x55\xbc\x03\x97\x6b\x8a\xc3\xdd\xa0\xda\xeb\x68\xed\x87\xb2\x69\x01\xa7\x8b\xc1\xb4\x1d\x3e\x9a\x0c\xa7\xb1\x5c\xd3\xdc\x25\x6a\x30\x55\x7e\xc3\xf0\x1b\x7e\xcd\x54\xec\x8b\xa7\x4d\xcf\x71\x0a\xea\x11\x00\x00\x4c\x6f\x6f\x73\x88\x5a\xc5\xe0\xf7\xc7\xbb\x4f\xd3\xc7\xaf\xe0\xb7\xd9\x57\xd0\x27\x6e\xd3\x63\x76\x75\x73\x47\xb6\x55\x2b\xd1\x99\x5a\x83\x56\x6d\xcb\x8d\x27\x63\xeb\xbc\xeb\xd6\x7a\x93\x9a\x2a\xfb\x2b\xa9\x59\x3d\xb0\xdc\x65\xb6\xcc\x8a\xbb\xf9\xed\xec\x8f\x7a\x87\x9e\x58\x34\x07\x01\x1e\xe6\xda\xd5\x05\x9e\x9f\xee\xe6\xff\x07\x96\x82\x61\x0c\xfa\xa9\xf0\xb0\x54\x20\xd4\x91\x93\x75\xce\x43\x98\xc9\xfe\xf5\x68\xa9\xd5\x55\x1d\x9b\x24\xe3\x1e\xc2\x27\x41\xa8\xc7\x48\x39\x7a\x0f\xcb\x55\x5a\xed\x84\x86\x38\xde\xb2\x31\xb7\x15\xd3\xe7\xf9\xdd\xe7\xe7\x8c\xb0\x02\x97\xa7\x9d\xbd\x3c\x2a\x30\xd6\x5d\x48\x0e\xb3\xcb\x47\x13\xd9\x7d\x7d\xeb\x40\x9a\xc4\xad\x4d\x70\x7f\x3b\x33\x04\x2d\x48\xd3\x10\x86\x5d\xf1\x4e\xb1\xf2\xd4\x0d\x81\xb8\x95\x25\x7a\x03\xc4\xb6\x3b\x03\xc4\xb6\x64\x80\x31\x9e\xd6\x36\xa1\x78\xd5\x56\x36\x82\x86\x72\x56\x6e\x68\x2b\x1b\x52\xf2\x7b\x8c\xb6\xce\xaf\x76\xf4\xee\xc1\xd8\xf2\xb5\x0b\x5f\x17\xe1\xf2\x94\x93\xef\x0a\x47\x3d\xa3\xbc\x5f\xbb\xa2\x55\xc2\xac\x17\xde\x74\x04\x45\x32\x24\xe2\x90\x61\xdd\x63\xb4\x9f\x92\xb6\xe9\x27\xe2\x51\x48\x2e\xc8\x0f\x60\x9a\x43\x51\xb8\xba\x58\x61\x56\x7a\x89\x30\x2c\x3f\x17\x18\xea\x5e\x1e\x98\xc8\xcb\x0b\xf9\x43\xa9\x4b\x0c\x1b\x71\xe5\x05\xc8\x50\x7d\xa8\x31\x2c\xbf\xf7\xd0\x51\x76\x77\x75\x88\x43\x48\xef\x51\x6c\xb4\xb3\x92\x87\x9e\x4b\xd8\xc1\xc2\x49\x71\x6c\x44\x9a\xa5\xa7\xa4\x74\x53\x2a\x5a\xd0\x00\xa6\x2f\x95\x0f\xa5\x6d\x55\x90\xb7\x27\x6b\x56\x36\x80\x89\x60\x03\xee\x87\x7b\xbb\x0a\xdb\xce\x58\x33\x0d\x8a\x80\x59\xad\x4c\x6e\xcc\xd2\x89\xd3\x7a\x9a\x5a\x91\xad\xbb\x9c\x7e\xbf\x9f\x5d\xc8\x8c\x7e\xf9\x05\xf4\xb2\x8e\xbd\xc9\x44\xde\x2f\x0f\x06\x93\x49\x72\xb5\x32\xa8\x6f\x96\x5c\xbb\xdd\x9b\x24\x51\xad\xe6\x48\x21\x0b\xd1\x34\x03\x4a\xc8\xdd\xdb\xe8\x8e\xd8\xea\xa0\xad\xc9\x77\x27\x59\x9f\x77\xd7\x73\xbc\x00\xdd\x66\xb7\x60\x86\x53\x6e\xa2\xba\x77\xb4\xaa\xc1\x4e\x5f\xe9\x50\xdf\x98\xdc\x9b\xfe\x37\xf3\x7f\x4e\x87\xd5\x92\x9c\x6c\x7d\x23\x74\x7f\xa1\xf0\x66\xd6\xe8\x94\x59\xcd\xd2\x75\xaa\x6f\x5f\x76\x04\x7e\x33\x9b\x32\x05\x56\x3b\x8c\xb5\x8a\x22\xf4\xbe\x54\xfc\x16\x4b\x5b\x45\xd7\x1e\x5f\x9a\x2e\xf0\x22\x68\x71\x03\xdc\xd1\x0a\xaf\x52\x51\xc7\x06\xcb\xae\xbc\x52\x59\x77\xe9\xab\x0c\x5c\x8b\xbb\x3d\x89\xe5\x8f\x4a\x6f\x31\x6d\xca\xf8\xad\x0f\x6a\xf1\x46\xb5\x70\x43\x28\xb7\xa3\x70\x49\xe9\xb7\xd6\x5e\xae\xc0\xac\xb1\xe3\x29\x6c\x78\x94\x33\xc7\x6e\xdf\x33\x04\x66\x41\x79\x16\xa9\x25\x98\x9c\x51\xcc\xa2\xa5\x93\x5a\x4d\xd1\x6a\x02\x9a\x93\xdd\x4e\x78\x00\xbe\x7c\x9c\x3d\xce\x92\x49\x06\xde\x83\x77\xef\xec\x6f\x5c\xa4\x83\xe3\xe7\x78\x87\xce\x31\x33\xb2\x1c\xb5\x52\xab\x12\x4e\x73\x0f\x63\x86\xb9\x37\x30\x83\x8a\x3f\x13\x97\xf8\xf1\xcf\x43\x99\xeb\x30\x25\xe7\xdc\x77\xe5\xe0\x92\x3b\xc5\xe6\x0f\xb0\x95\x67\x57\x45\x8b\xec\xd1\x7a\x89\x68\xb0\x6a\x10\x96\x62\x36\x5a\x87\xc7\x9a\x12\x60\x25\x33\x4d\x4c\x51\x01\x62\x77\x76\xe5\xaa\x04\xad\x86\xb3\x0c\xa3\x58\xfc\x5b\x76\x89\x28\xff\x90\xfd\x50\x9f\xe9\x51\x25\xcb\x62\x4b\x91\xa8\x94\xb1\xbc\xad\x94\x05\xb0\xdd\xc3\xbe\xd6\x3e\xac\xc0\x94\x1c\x0b\xcd\xfa\xa2\x85\x66\x98\x4b\xa0\x87\x4f\x3d\x0d\xa4\x85\x5f\x1d\x5e\x62\xfb\x06\xcc\xc4\xd6\xca\x4d\x8a\x0c\x41\x81\xa1\xe9\x7f\xaf\xec\x5e\xfb\xc5\x04\xfe\x3b\x00\x32\x88\x4f\xd0\xa8\x45\x00\x00")
 
 func latestSqlBytes() ([]byte, error) {
 	return bindataRead(
@@ -88,12 +93,32 @@ func latestSql() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "latest.sql", size: 13812, mode: os.FileMode(420), modTime: time.Unix(1501102705, 0)}
+	info := bindataFileInfo{name: "latest.sql", size: 17832, mode: os.FileMode(420), modTime: time.Unix(1792322058, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
 
-var _migrations1_initial_schemaSql = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\xc4\x5a\x5f\x6f\xdb\xc8\x11\x7f\xf7\xa7\x18\xdc\x8b\x6c\xd4\x6a\x2f\xb8\xe2\x70\x95\xe1\x03\x14\x99\x69\x84\xca\x54\x22\x51\x4d\x82\xc3\x61\xb1\x22\x47\xd4\xd6\xe4\x2e\xb3\xbb\x74\xa4\x2b\xfa\xdd\x0b\x52\x24\xc5\xff\xa4\x1c\xc9\xf7\x28\xee\xec\xcc\xfc\x66\x66\x7f\x33\x5c\x6a\x38\x84\xbf\xf8\xcc\x95\x54\x23\xac\x82\xab\xe1\xf0\x6a\x38\x84\x0f\x42\x69\x57\xe2\xf2\xe3\x0c\x1c\xaa\xe9\x9a\x2a\x04\x27\xf4\xe3\xe5\xab\xa5\x61\x81\xd2\x54\xa3\x8f\x5c\x13\xcd\x7c\x14\xa1\x86\x7b\xf8\xf1\x2e\x5e\xf2\x84\xfd\x54\x7d\x6a\x7b\x2c\x92\x46\x6e\x0b\x87\x71\x17\xee\x61\xb0\xb2\xde\xfd\x32\xb8\x4b\xd5\x71\x87\x4a\x87\xd8\x82\x6f\x84\xf4\x19\x77\x89\xd2\x92\x71\x57\xc1\x3d\x08\x9e\xe8\xd8\xa2\xfd\x44\x36\x21\xb7\x35\x13\x9c\xac\x85\xc3\x30\x5a\xdf\x50\x4f\x61\xc1\x8c\xcf\x38\xf1\x51\x29\xea\xc6\x02\xdf\xa8\xe4\x8c\xbb\x77\x57\x09\x3c\x93\xfa\x38\x82\xc0\x0b\x5c\xf5\xd5\xbb\x03\x6b\x1f\xe0\x08\x8c\xcf\x96\x61\x2e\xa7\x73\xf3\x0e\x96\xf6\x16\x7d\x3a\x82\xe1\x1d\xcc\xbf\x71\x94\x23\x18\xc6\xc8\x27\x0b\x63\x6c\x19\x47\x49\x98\xbe\x03\x73\x6e\x81\xf1\x79\xba\xb4\x96\xa9\x42\xf8\x34\xb5\xde\xc3\x72\xf2\xde\x78\x1c\x43\xe0\x12\x9b\x6a\xea\x89\xc8\x7a\xc1\xfc\x51\x4b\xc9\x91\xc9\xfc\xf1\xd1\x30\xad\x16\x37\x0e\x02\x30\x37\xab\x4a\x60\xba\x84\xc1\x87\xd9\xdf\x02\x37\x4a\x5e\x20\x85\x8d\x4e\x28\xa9\x07\x1e\xe5\x6e\x48\x5d\x1c\x94\xfd\xd8\x2a\x2d\x24\x9e\x2f\x0a\x07\x7d\xc5\x20\x84\x6b\x8f\xd9\xcd\x01\x28\xba\xf0\x32\xfc\x89\xd9\x08\x7e\x54\xb2\xa0\xf7\x01\xc2\x46\x48\x88\x9e\x47\x15\xa7\x50\x2b\x10\x1b\xb8\x7e\xc2\xfd\x2d\x3c\x53\x2f\xc4\x1b\x08\x28\x93\x2a\x0e\x49\x5c\x86\x48\xa5\xbd\x25\x01\xd5\x5b\xb8\x4f\xbc\xbe\x2d\xa6\x30\x12\x73\x70\x43\x43\x4f\x13\x4d\xd7\x1e\xaa\x80\xda\x18\x95\xf3\xa0\xb4\xfa\x8d\xe9\x2d\x11\xcc\xc9\x55\x68\x31\xee\x2c\xf2\x6c\x4f\xa8\x6d\x8b\x90\x6b\x95\xc2\xb7\xc6\x6f\x67\xc6\x11\x7c\x12\xbb\x2c\x02\x77\x60\x65\x66\x47\xf9\x7c\xc4\xfb\x2a\x5a\xe1\xfa\x0a\x00\x80\x39\xb0\x66\x2e\xe3\x3a\xce\x94\xb9\x9a\xcd\x6e\xe3\xe7\xd4\x71\x24\x2a\x05\xf6\x96\x4a\x6a\x6b\x94\xf0\x4c\xe5\x9e\x71\xf7\xfa\xe7\xbf\xdf\x5c\xdd\x54\x6a\x25\xd1\x8e\x9b\x0d\xda\xe7\x76\x39\x51\x9a\x78\x5c\x02\x42\x9a\x10\xa4\x72\x22\x40\x49\x63\x5e\x68\x92\xfc\x41\x48\x07\xe5\x0f\xc0\xb8\x46\x17\x65\x69\x35\xae\x97\xfa\x25\x07\x35\x65\x9e\x82\xff\x28\xc1\xd7\xcd\x41\xf1\xd0\x71\x51\x9e\x39\x28\x89\xd2\x24\x28\x0a\xbf\x86\xc8\xed\x26\x47\x0f\xc2\x64\x4b\xd5\xb6\x3e\xa3\x25\xf9\x40\xe2\x33\x13\xa1\x22\x9d\x1b\x93\x18\x49\xca\x15\x3d\xb0\x6f\x9c\x95\xcc\x8f\x07\xe3\xdd\x78\x35\xb3\xe0\xc7\x92\x85\x63\x56\xfa\xc9\xdb\x9e\x50\xe8\x10\xaa\x21\xea\x20\x4a\x53\x3f\x80\xe8\x20\x45\xbd\x24\x7a\x02\x7f\x08\x8e\xe5\x3d\x12\xa9\xee\xdc\x74\x90\x0d\x03\xa7\xb7\x6c\x56\x47\xc9\x4f\x3f\x10\x52\xa3\x24\xcf\x28\x15\x13\xbc\x82\xe5\x4d\xb9\xa2\x84\xa6\x1e\xb1\x05\xe3\xaa\xbe\x20\x37\x88\x24\x10\xc2\xab\x5f\x8d\x9a\x2e\xd9\x60\x53\xae\xe3\x65\x89\x0a\xe5\x73\x93\x88\x4f\x77\x44\xef\x88\x42\x4d\x14\xfb\xa3\x2a\xd5\x5c\xca\xc7\xb4\x05\x54\x6a\x66\xb3\x80\x9e\x9d\xa1\xea\x6d\x1c\xf9\xaa\x1e\x53\xff\xe3\xde\x4d\x20\xa7\xe2\x27\xcc\x21\x0a\xbf\xa6\x61\x58\x1a\x1f\x57\x86\x39\x69\x89\x44\x1e\x7c\x2a\xdd\xcf\x46\x8c\x60\x69\x8d\x17\xd6\xa1\x91\xbe\x89\x1f\x4c\xcd\xc9\xc2\x88\x5b\xdf\xdb\x2f\xc9\x23\x73\x0e\x8f\x53\xf3\xdf\xe3\xd9\xca\xc8\x7e\x8f\x3f\x1f\x7f\x4f\xc6\x93\xf7\x06\xbc\x39\x0b\x50\x98\x7f\x32\x8d\x07\x78\xfb\xa5\x03\xf1\x78\x66\x19\x8b\x13\x01\x67\xba\x3b\xc4\xff\xca\x9c\x4e\x2c\x97\x2a\xd4\xae\x66\x9a\xa7\xc7\xc6\x86\x1b\x04\x1e\xb3\x0f\xb8\xe2\x7e\xf4\x9d\xed\xe8\xf0\x48\x89\x50\xda\x98\x96\x7a\x03\xf7\xa7\x3c\x35\x18\x8c\x46\x15\x89\x1e\x87\x22\x0f\xef\x72\xb4\xd0\x64\x25\x8e\x7d\x03\x2d\xd4\xed\xad\x4f\xc0\xf7\x90\x42\x93\x67\xe7\xa5\x85\x0e\x2b\xaf\x45\x0c\x27\x82\xfd\x4e\x6a\xe8\xb0\x56\x25\x87\xa6\x0d\x2d\xf4\x90\xdb\x72\xb9\x92\x4d\x29\x22\xef\x5f\xef\x71\x2c\x99\xc2\x3a\x86\xbc\xbe\x0c\xd2\x4e\x06\xb5\xb2\x47\xd3\xcd\xf3\x0a\x6d\x6c\xcd\x4d\xb3\xde\x9f\x32\xad\xe9\x1d\x41\xfe\x8c\x9e\x08\x10\x34\xee\x2a\x54\xbd\x8b\x66\xa7\xd0\xd3\x0d\x8b\x3e\x46\xaf\x90\xb5\x4b\x51\x14\x9a\x96\x15\x73\x39\xd5\xa1\xc4\xba\x37\xaa\x7f\xfc\x7c\xf3\xdb\xef\x47\x16\xfe\xef\xff\xea\x78\xf8\xb7\xdf\xcb\x43\x1c\xfa\x82\xc4\xdd\xa0\xca\xd9\x99\x2e\x2e\x38\xb6\xb2\xfa\x51\x57\x55\x4d\x82\x8c\xf9\x48\xd6\x22\xe4\x8e\x8a\x32\xf7\x8b\xa4\xdc\xc5\x98\x0c\xf3\x87\x89\x39\xe9\xd1\x49\x6c\xf7\x3a\xef\x87\xe3\x32\x37\x67\x5d\xdd\x1d\x0e\xf2\x93\xf9\x6c\xf5\x68\x46\x29\x8d\x5e\xa8\x53\x94\x1c\x77\xfa\x99\x7a\xd7\x83\x5e\x03\xc5\x60\x34\x92\xe8\xda\x1e\x55\xaa\xc2\xe8\x67\x43\xd1\xd8\xac\x4e\xc2\xd1\xc1\x7e\x6d\x48\x3a\x42\x11\x3c\xe1\xfe\x78\xad\x62\x2e\xad\xc5\x78\x6a\xb6\xa0\xad\x12\xde\x89\x09\x8c\x4b\x69\xfc\xf0\x90\xb3\xd6\xc7\x47\xf8\xb0\x98\x3e\x8e\x17\x5f\xe0\x5f\xc6\x17\xb8\x66\xce\xe9\x3d\xf8\x82\x48\x9b\x6c\xb6\x61\x6d\xf5\xb3\x13\xed\x3a\x1b\x50\x52\x48\x53\xf3\xc1\xf8\xfc\x82\x46\x15\xef\xcb\xe9\x83\xb9\x59\xdf\xb6\x56\xcb\xa9\xf9\x4f\x58\x6b\x89\x08\xd7\x89\xf0\x6d\xa5\x2f\xd4\x79\x1a\xb5\xb7\xb3\xb9\x19\xf7\xca\x5e\x3e\x96\x3b\x6c\x9d\x6b\x87\x86\x7a\x36\xe7\x0e\xea\xfa\xb9\x57\xea\xe5\xb7\xd5\xb6\x5d\x5b\xe3\x04\xc9\x7a\x7f\x58\xff\x5e\xb7\x57\xe6\xf4\xe3\x2a\xf5\xbe\xa4\x3b\x8f\x21\xbd\x76\x2b\xb8\x5f\xf7\x9a\x7d\x9b\xde\xa0\x35\x79\x7e\xa4\xd5\x73\xfa\xcc\x9c\xde\xde\x1e\xa7\xfa\xdb\xda\x8b\x82\x0e\x04\x22\x20\xc1\x45\x40\x24\x8a\xf3\x38\x1a\xfa\xdf\x8b\x60\x55\xd1\x64\x37\x7a\xeb\xfd\xd9\x01\x15\x75\xe7\x31\xa5\x77\x95\x05\x10\xf5\xee\xe5\x4f\xef\x45\x7c\xac\x18\xe8\x77\x6c\x6b\xbc\x65\xdc\xc1\x1d\x29\xdf\xab\x13\xc1\x49\x72\x79\x7e\x56\xd7\x3b\xad\xe5\x71\x64\x97\xfc\x45\xf6\x3e\x08\x9e\x00\xe4\xcc\xe1\x6f\x33\xd4\xed\x7e\x67\x0a\x12\x0a\x88\xf4\x45\x73\xf1\x79\xe8\xbd\xd5\x44\x27\x01\x45\x42\x1d\x5e\x27\x87\x23\x52\x99\x5d\x72\x5f\xc2\xf5\x3a\x3b\x9d\x87\x34\x93\xec\x0f\xe2\xa2\x35\x53\xb0\xf3\x12\x8a\x69\x56\x57\xba\xc5\xbf\x70\x0a\x2a\x1f\x0d\x3a\xb1\x94\x36\xf4\x47\x96\xfb\x86\xf3\x3a\x99\xc9\x7f\x34\xea\x82\x95\x93\xed\x8f\xa8\xee\xf3\xd4\xeb\x40\xab\xfd\x30\xd6\x85\xb1\x6e\x53\x7f\xb0\xe9\xa4\xf8\x3a\x00\xb3\x8b\x9e\x2e\x50\x8d\x93\x7f\x51\xf5\xf1\x8e\xfc\xe2\xdc\x50\x36\x55\x3b\x55\x9d\xca\x10\x45\xa5\xc5\x7b\xe4\x4b\x50\x44\x9b\xbd\x3e\x80\x8a\x3b\x4e\x03\x77\xa1\x9e\x59\xb5\xd2\x0b\x48\x5d\xe7\x8c\x87\x66\xbd\xbb\xd0\x34\x9e\x28\x6e\x18\x08\x5f\x38\x8f\x57\x13\xd2\x9c\x8f\xfc\xf8\x79\xf1\xe3\x52\x35\xf6\xe2\x49\x58\x4b\xea\x60\x36\x1b\xa5\xef\x92\x64\x2d\xc4\xd3\x79\x0a\xaa\xc5\x40\xe7\x08\x76\x7d\x9d\x7e\x17\x1b\xfe\xfa\x2b\x0c\x94\xf0\x1c\x42\x95\x42\x1d\x97\xe2\x60\x34\xd2\xb8\xd3\x37\x37\xb7\xd0\x2c\x68\x0b\xa7\x9f\x20\x53\x2a\x44\xd9\x2c\xba\x16\xa1\xbb\xd5\xbd\xcc\x17\x44\xdb\x1d\x28\x88\x96\x5c\xb8\x81\x4f\xef\x8d\x85\x71\x38\x4f\x70\x0f\x3f\xfd\x94\xcb\x5e\xd3\xbf\xf9\xc0\x16\x7e\xe0\xa1\xc6\x38\x13\xf9\x3f\x02\x3e\x88\x6f\xfc\xca\x91\x22\x80\xf8\x3f\x4e\xf5\xe5\x62\x53\x65\x53\x07\xef\x3a\x04\x8b\x07\xaa\x6d\x53\x8e\x23\x7a\x89\xf5\xd7\x9c\xb6\xb6\x36\x99\xb4\xaa\xda\x64\xb2\x37\x96\x4c\xe8\xff\x01\x00\x00\xff\xff\x5d\xb2\x1f\x7d\x3f\x29\x00\x00")
+var _migrations10_index_effects_by_offer_idSql = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\xd2\xd5\x55\xd0\xce\xcd\x4c\x2f\x4a\x2c\x49\x55\x08\x2d\xe0\x72\x0e\x72\x75\x0c\x71\x55\xf0\xf4\x73\x71\x8d\x50\xc8\xcc\x4b\x49\xad\x88\xcf\xc8\x2c\x2e\xc9\x2f\xaa\x8c\x4f\x4d\x4b\x4b\x4d\x2e\x29\x8e\xcf\xcf\x8b\xcf\x4f\x4b\x4b\x2d\x8a\xcf\x4c\x51\xf0\xf7\x53\x40\x93\x56\x08\x0d\xf6\xf4\x73\x57\x48\x2a\x29\x4a\x4d\x55\xd0\xd0\xd0\x48\x49\x2d\x49\xcc\xcc\x29\xd6\xb5\xb3\x53\x87\xe9\x52\xd7\xb4\xb2\x4a\xca\x4c\xcf\xcc\x2b\xd1\xd4\xb4\xe6\xe2\x42\x76\x81\x4b\x7e\x79\x1e\x97\x4b\x90\x7f\x00\xb1\x2e\xb0\xe6\x02\x0c\x00\xfd\xb2\x61\xa1\xc1\x00\x00\x00")
+
+func migrations10_index_effects_by_offer_idSqlBytes() ([]byte, error) {
+	return bindataRead(
+		_migrations10_index_effects_by_offer_idSql,
+		"migrations/10_index_effects_by_offer_id.sql",
+	)
+}
+
+func migrations10_index_effects_by_offer_idSql() (*asset, error) {
+	bytes, err := migrations10_index_effects_by_offer_idSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "migrations/10_index_effects_by_offer_id.sql", size: 193, mode: os.FileMode(420), modTime: time.Unix(1792320805, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _migrations1_initial_schemaSql = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\xc4\x5a\x6d\x8f\xe2\xc8\x11\xfe\x3e\xbf\xa2\x74\x5f\x98\x51\x86\xe4\x56\x17\x9d\x2e\xa0\x39\x89\x65\xbc\x59\x14\xc6\xec\x82\xc9\xee\xea\x74\x6a\x35\x76\x61\x3a\x63\x77\x7b\xbb\xdb\xb3\xc3\x45\xf9\xef\x91\xdf\xc0\xef\x36\xb3\x30\xf7\x11\xf7\xd3\x55\xf5\x74\x55\x3f\x55\x18\x86\x43\xf8\x8b\xcf\x5c\x49\x35\xc2\x3a\xb8\x1a\x0e\xaf\x86\x43\xf8\x20\x94\x76\x25\xae\x3e\xce\xc1\xa1\x9a\x6e\xa8\x42\x70\x42\x3f\x5e\xbe\x5a\x19\x16\x28\x4d\x35\xfa\xc8\x35\xd1\xcc\x47\x11\x6a\xb8\x83\x1f\xc7\xf1\x92\x27\xec\xc7\xea\x53\xdb\x63\x11\x1a\xb9\x2d\x1c\xc6\x5d\xb8\x83\xc1\xda\x7a\xf7\xcb\x60\x9c\x99\xe3\x0e\x95\x0e\xb1\x05\xdf\x0a\xe9\x33\xee\x12\xa5\x25\xe3\xae\x82\x3b\x10\x3c\xb5\xb1\x43\xfb\x91\x6c\x43\x6e\x6b\x26\x38\xd9\x08\x87\x61\xb4\xbe\xa5\x9e\xc2\x82\x1b\x9f\x71\xe2\xa3\x52\xd4\x8d\x01\xdf\xa8\xe4\x8c\xbb\xe3\xab\x94\x9e\x49\x7d\x1c\x41\xe0\x05\xae\xfa\xea\x8d\xc1\xda\x07\x38\x02\xe3\xb3\x65\x98\xab\xd9\xc2\x1c\xc3\xca\xde\xa1\x4f\x47\x30\x1c\xc3\xe2\x1b\x47\x39\x82\x61\xcc\x7c\xba\x34\x26\x96\x71\x44\xc2\xec\x1d\x98\x0b\x0b\x8c\xcf\xb3\x95\xb5\xca\x0c\xc2\xa7\x99\xf5\x1e\x56\xd3\xf7\xc6\xc3\x04\x02\x97\xd8\x54\x53\x4f\x44\xde\x0b\xee\x8f\x56\x4a\x81\x4c\x17\x0f\x0f\x86\x69\xb5\x84\x91\x00\x60\x61\x56\x8d\xc0\x6c\x05\x83\x0f\xf3\xbf\x05\x6e\x94\xbc\x40\x0a\x1b\x9d\x50\x52\x0f\x3c\xca\xdd\x90\xba\x38\x28\xc7\xb1\x53\x5a\x48\x3c\xdf\x29\x24\xf6\x8a\x87\x10\x6e\x3c\x66\x37\x1f\x40\x31\x84\x97\xf1\x4f\xdd\x46\xf4\xa3\x92\x05\xbd\x0f\x10\xb6\x42\x42\xf4\x3c\xaa\x38\x85\x5a\x81\xd8\xc2\xf5\x23\xee\x6f\xe1\x89\x7a\x21\xde\x40\x40\x99\x54\xf1\x91\xc4\x65\x88\x54\xda\x3b\x12\x50\xbd\x83\xbb\x34\xea\xdb\x62\x0a\x23\x98\x83\x5b\x1a\x7a\x9a\x68\xba\xf1\x50\x05\xd4\xc6\xa8\x9c\x07\xa5\xd5\x6f\x4c\xef\x88\x60\x4e\xae\x42\x8b\xe7\xce\xa2\xc8\xf6\x84\xda\xb6\x08\xb9\x56\x19\x7d\x6b\xf2\x76\x6e\x1c\xc9\xa7\x67\x77\x38\x81\x31\x58\x07\xb7\xa3\x7c\x3e\xe2\x7d\x15\xab\x70\x7d\x05\x00\xc0\x1c\xd8\x30\x97\x71\x1d\x67\xca\x5c\xcf\xe7\xb7\xf1\x73\xea\x38\x12\x95\x02\x7b\x47\x25\xb5\x35\x4a\x78\xa2\x72\xcf\xb8\x7b\xfd\xf3\xdf\x6f\xae\x6e\x2a\xb5\x92\x5a\xc7\xed\x16\xed\x73\x87\x9c\x1a\x4d\x23\x2e\x11\x21\x4d\x0c\x32\x9c\x08\x50\xd2\x58\x17\x9a\x90\x3f\x08\xe9\xa0\xfc\x01\x18\xd7\xe8\xa2\x2c\xad\xc6\xf5\x52\xbf\xe4\xa0\xa6\xcc\x53\xf0\x1f\x25\xf8\xa6\xf9\x50\x3c\x74\x5c\x94\x67\x3e\x94\xd4\x68\x7a\x28\x0a\xbf\x86\xc8\xed\xa6\x40\x13\x30\xd9\x51\xb5\xab\xcf\x68\x09\x1f\x48\x7c\x62\x22\x54\xa4\x73\x63\x7a\x46\x92\x72\x45\x13\xf5\x8d\xb3\x72\x88\xe3\xde\x78\x37\x59\xcf\x2d\xf8\xb1\xe4\xe1\x98\x95\x7e\x78\xdb\x13\x0a\x1d\x42\x35\x44\x1d\x44\x69\xea\x07\x10\x5d\x24\x11\x26\x4f\xe0\x0f\xc1\xb1\xbc\x47\x22\xd5\x9d\x9b\x12\x6c\x18\x38\xbd\xb1\x87\x3a\x4a\x3f\xfa\x81\x90\x1a\x25\x79\x42\xa9\x98\xe0\x15\x2e\x6f\xca\x15\x25\x34\xf5\x88\x2d\x18\x57\xf5\x05\xb9\x45\x24\x81\x10\x5e\xfd\x6a\xd4\x74\xc9\x16\x9b\x72\x1d\x2f\x4b\x54\x28\x9f\x9a\x20\x3e\x7d\x26\xfa\x99\x28\xd4\x44\xb1\x3f\xaa\xa8\xe6\x52\x3e\xa6\x2d\xa0\x52\x33\x9b\x05\xf4\xec\x0a\x55\xef\xe3\xa8\x57\xf5\x9c\xfa\x5f\xf7\x6e\x01\x39\x95\x3f\x61\x0e\x51\xf8\x35\x3b\x86\x95\xf1\x71\x6d\x98\xd3\x96\x93\xc8\x93\xcf\xd0\xfd\x7c\xc4\x0c\x56\xd6\x64\x69\x25\x8d\xf4\x4d\xfc\x60\x66\x4e\x97\x46\xdc\xfa\xde\x7e\x49\x1f\x99\x0b\x78\x98\x99\xff\x9e\xcc\xd7\xc6\xe1\xf3\xe4\xf3\xf1\xf3\x74\x32\x7d\x6f\xc0\x9b\xb3\x10\x85\xc5\x27\xd3\xb8\x87\xb7\x5f\x3a\x18\x4f\xe6\x96\xb1\x3c\x91\xf0\xc1\x76\x07\xfc\xaf\xcc\xe9\xe4\x72\xa9\x42\xed\x6a\xa6\x79\x79\x6c\xc2\xd0\x20\xf0\x98\x9d\xf0\x8a\xfb\xd1\x77\xb6\xa3\xe4\x91\x12\xa1\xb4\x31\x2b\xf5\x06\xed\xcf\x74\x6a\x30\x18\x8d\x2a\x88\x1e\x97\x22\x4f\xef\x72\xb2\xd0\xe4\x25\x3e\xfb\x06\x59\xa8\xdb\x5b\x9f\x80\xef\x11\x85\xa6\xc8\xce\x2b\x0b\x1d\x5e\x5e\x4b\x18\x4e\x24\xfb\x9d\xd2\xd0\xe1\xad\x2a\x0e\x4d\x1b\x5a\xe4\x21\xb7\xe5\x72\x25\x9b\x49\x44\x3e\xbe\xde\xe3\x58\x3a\x85\x75\x0c\x79\x7d\x15\xa4\x5d\x0c\x6a\xb1\x47\xd7\xcd\xf3\x0a\x6d\x6c\xcd\x4d\xb3\xde\x9f\x32\xad\xe9\x67\x82\xfc\x09\x3d\x11\x20\x68\x7c\xae\x48\xf5\x73\x34\x3b\x85\x9e\x6e\x58\xf4\x51\xd3\x86\xa5\xe8\x14\x9a\x96\x15\x73\x39\xd5\xa1\xc4\xba\x6f\x54\xff\xf8\xf9\xe6\xb7\xdf\x8f\x2a\xfc\xdf\xff\xd5\xe9\xf0\x6f\xbf\x97\x87\x38\xf4\x05\x89\xbb\x41\x05\x7b\xb4\xc5\x05\xc7\x56\x55\x3f\xda\xaa\x9a\x49\x99\x31\x1f\xc9\x46\x84\xdc\x51\x51\xe6\x7e\x91\x94\xbb\x18\x8b\x61\xfe\x32\x31\x27\xbb\x3a\xa9\xef\x5e\xf7\x3d\xb9\x2e\x0b\x73\xde\xd5\xdd\x21\xc1\x4f\x17\xf3\xf5\x83\x19\xa5\x34\xfa\x42\x9d\xb1\xe4\xf8\xac\x9f\xa8\x77\x3d\xe8\x35\x50\x0c\x46\x23\x89\xae\xed\x51\xa5\x2a\x8a\x7e\x36\x16\x8d\xcd\xea\x24\x1e\x1d\xea\xd7\xc6\xa4\xe3\x28\x82\x47\xdc\x1f\x5f\xab\x98\x2b\x6b\x39\x99\x99\xd6\x29\x82\x77\x62\x02\xe3\x52\x9a\xdc\xdf\xe7\xbc\xf5\x89\x11\x3e\x2c\x67\x0f\x93\xe5\x17\xf8\x97\xf1\x05\xae\x99\x73\x7a\x0f\xbe\x20\xd3\x26\x9f\x6d\x5c\x5b\xe3\xec\x64\xbb\x39\x0c\x28\x19\xa5\x99\x79\x6f\x7c\x7e\x41\xa3\x8a\xf7\xe5\xec\xc1\xc2\xac\x8b\x51\xc1\x7a\x35\x33\xff\x09\x1b\x2d\x11\xe1\x3a\x05\xdf\x56\xfa\x42\x5d\xa4\x51\x7b\x3b\x5b\x98\x91\xb1\x7e\x31\x96\x3b\x6c\x5d\x68\x49\x43\x3d\x5b\x70\x89\xb9\x7e\xe1\x95\x7a\xf9\x6d\xb5\x6d\xd7\xd6\x38\x41\xb2\xd9\x27\xeb\xdf\x1b\xf6\xda\x9c\x7d\x5c\x67\xd1\x97\x6c\xe7\x39\x64\xaf\xdd\x0a\xe1\xd7\x7d\xcd\xbe\xcd\xde\xa0\x35\x45\x7e\x94\xd5\x73\xc6\xcc\x9c\xde\xd1\x1e\xa7\xfa\x5b\x78\x01\x03\x11\x90\xe0\x22\x24\x52\xc3\x79\x1e\x0d\xfd\xef\x45\xb4\xaa\x6c\x0e\x6f\xf4\x36\xfb\xb3\x13\x2a\xda\xce\x73\x4a\x9e\x97\x48\xd4\x87\x97\xbf\xbd\x17\x89\xb1\xe2\xa0\xdf\xb5\xad\x89\x96\x71\x07\x9f\x49\xf9\xbd\x3a\x11\x9c\xa4\x2f\xcf\xcf\x1a\x7a\xa7\xb7\x3c\x8f\x6c\xb9\xa4\xde\x09\xf0\x04\x22\x67\x3e\xfe\x36\x47\xdd\xe1\x77\xa6\x20\x95\x80\xc8\x5e\x34\x17\x9f\x47\xde\x5b\x5d\x74\x0a\x50\x04\xea\x88\x3a\xbd\x1c\x91\xc9\xc3\x4b\xee\x4b\x84\x5e\xe7\xa7\xf3\x92\x1e\x90\xfd\x49\x5c\xb4\x66\x0a\x7e\x5e\x22\x31\xcd\xe6\x4a\x6f\xf1\x2f\x9c\x82\xb2\xbb\x6e\x2e\xa5\x0d\xfd\x99\xe5\x7e\xc3\x79\x9d\xcc\xe4\x1c\x76\xd2\xca\x61\xfb\x33\xaa\xfb\x79\xea\x75\xa8\xd5\x79\xee\xe4\x58\xb7\xa9\x3f\xd9\x6c\x52\x7c\x1d\x82\x99\xb7\x4e\x52\x8d\x93\x7f\xd1\xf4\xf1\x1d\xf9\xc5\xb5\xa1\xec\xaa\x76\xaa\x3a\x55\x21\x8a\x46\x8b\xef\x91\x2f\x21\x11\x6d\xfe\xfa\x10\x2a\xee\x38\x8d\xdc\x85\x7a\x66\xd5\x4b\x2f\x22\x75\x9d\x33\xda\x14\xfd\x72\x79\x99\x69\x3c\x35\xdc\x30\x10\xbe\x70\x1e\xaf\x26\xa4\x39\x1f\x39\xec\xe5\xaf\x4b\xd5\xd9\x8b\x27\x61\x2d\xa9\x83\x87\xd9\x28\xfb\x2e\x49\x36\x42\x3c\x9e\xa7\xa0\x5a\x1c\x74\x8e\x60\xd7\xd7\xd9\xef\x62\xc3\x5f\x7f\x85\x81\x12\x9e\x43\xa8\x52\xa8\xe3\x52\x1c\x8c\x46\xd1\xeb\xda\x9b\x9b\x5b\x68\x06\xda\xc2\xe9\x07\x64\x4a\x85\x28\x9b\xa1\x1b\x11\xba\x3b\xdd\xcb\x7d\x01\xda\x1e\x40\x01\x5a\x0a\xe1\x06\x3e\xbd\x37\x96\x46\x72\x9f\xe0\x0e\x7e\xfa\x29\x97\xbd\xa6\x7f\xf3\x81\x2d\xfc\xc0\x43\x8d\x71\x26\xf2\x7f\x04\xbc\x17\xdf\xf8\x95\x23\x45\x00\xf1\x7f\x9c\xea\xcb\xc5\xa6\xca\xa6\x0e\x8e\x3b\x80\xc5\x0b\xd5\xb6\x29\xa7\x11\xbd\x60\xfd\x2d\x67\xad\xad\x0d\x93\x55\x55\x1b\xe6\xf0\x8d\xe5\x00\xfa\xff\x00\x5d\xb2\x1f\x7d\x3f\x29\x00\x00")
 
 func migrations1_initial_schemaSqlBytes() ([]byte, error) {
 	return bindataRead(
@@ -113,7 +138,7 @@ func migrations1_initial_schemaSql() (*asset, error) {
 	return a, nil
 }
 
-var _migrations2_index_participants_by_toidSql = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\x8c\x8f\xb1\xca\xc2\x50\x0c\x46\xf7\x3c\x45\xc6\xff\x47\xfa\x04\x9d\xc4\x16\xe9\xd2\x4a\xb5\xe0\x76\x49\xdb\x8b\xcd\xe0\xcd\x25\x37\x20\x7d\x7b\x41\x07\x5b\xbb\xb8\x86\x8f\x73\x72\xb2\x0c\x77\x77\xbe\x29\x99\xc7\x2e\x02\x1c\xda\x72\x7f\x29\xb1\xaa\x8b\xf2\x8a\x93\x44\xd7\xcf\x6e\x12\x1e\xb1\xa9\x71\xe2\x64\xa2\xb3\x93\xe8\x95\x8c\x25\xb8\x48\x6a\x3c\x70\xa4\x60\x09\xbb\x73\x55\x1f\xb1\x37\xf5\x1e\xff\xb6\x5b\x1e\xff\xf3\x2f\xbc\xbd\xf1\xb6\xc6\x9b\x52\x48\x34\xfc\x28\x58\xae\x5f\x0a\x58\x26\x15\xf2\x08\x00\x45\xdb\x9c\xb6\x49\xf9\xea\xfe\xf9\x25\x87\x67\x00\x00\x00\xff\xff\x33\xec\x54\x7a\x15\x01\x00\x00")
+var _migrations2_index_participants_by_toidSql = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\x8c\x8f\xb1\xca\xc2\x50\x0c\x46\xf7\x3c\x45\xc6\xff\x47\xfa\x04\x9d\xc4\x16\xe9\xd2\x4a\xb5\xe0\x76\x49\xdb\x8b\xcd\xe0\xcd\x25\x37\x20\x7d\x7b\x41\x07\x5b\xbb\xb8\x86\x8f\x73\x72\xb2\x0c\x77\x77\xbe\x29\x99\xc7\x2e\x02\x1c\xda\x72\x7f\x29\xb1\xaa\x8b\xf2\x8a\x93\x44\xd7\xcf\x6e\x12\x1e\xb1\xa9\x71\xe2\x64\xa2\xb3\x93\xe8\x95\x8c\x25\xb8\x48\x6a\x3c\x70\xa4\x60\x09\xbb\x73\x55\x1f\xb1\x37\xf5\x1e\xff\xb6\x5b\x1e\xff\xf3\x2f\xbc\xbd\xf1\xb6\xc6\x9b\x52\x48\x34\xfc\x28\x58\xae\x5f\x0a\x58\x26\x15\xf2\x08\x00\x45\xdb\x9c\xb6\x49\xf9\xea\xfe\xf9\x25\x87\xe7\x00\x33\xec\x54\x7a\x15\x01\x00\x00")
 
 func migrations2_index_participants_by_toidSqlBytes() ([]byte, error) {
 	return bindataRead(
@@ -133,7 +158,7 @@ func migrations2_index_participants_by_toidSql() (*asset, error) {
 	return a, nil
 }
 
-var _migrations3_use_sequence_in_history_accountsSql = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\x9c\x91\x4d\x6b\xb3\x40\x14\x85\xf7\xf3\x2b\xce\x2e\xca\xfb\x66\x91\x6d\x5c\x4d\xc6\x1b\x22\x8c\x63\x3b\x5e\xdb\x64\x25\xa2\x43\x3a\x90\x6a\xeb\xd8\xaf\x7f\x5f\x48\xd3\x0f\x08\x6d\xa1\xcb\x73\x78\xe0\x39\xdc\x3b\x9f\xe3\xdf\xad\xdf\x8f\xcd\xe4\x50\xdd\x09\x65\x49\x32\xa1\xa4\xcb\x8a\x8c\x22\xdc\xf8\x30\x0d\xe3\x4b\xdd\xb4\xed\xf0\xd0\x4f\xa1\xf6\x5d\x1d\xdc\xbd\x00\x80\x92\xa5\x65\x5c\x67\xbc\xc1\xe2\x58\x64\x46\x59\xca\xc9\x30\x56\xbb\x53\x65\x0a\xe4\x99\xb9\x92\xba\xa2\x8f\x2c\xb7\x9f\x59\x49\xb5\x21\x2c\x12\x51\x92\x26\xc5\x08\x6e\x7a\x6c\x0e\xd1\xec\x1b\xef\xec\x3f\xa2\x13\x99\xcb\x6d\xe4\xbb\x18\x6b\x5b\xe4\x67\x33\xe3\x38\x11\x52\x33\x59\xb0\x5c\x69\x42\x61\xf4\xee\x0c\xc2\x1b\xa1\x0a\x5d\xe5\x06\xbe\x43\x49\x8c\x94\xd6\xb2\xd2\x8c\xde\x3d\xff\xbc\x64\xb9\x1c\xdd\xbe\x3d\x34\x21\xc4\x89\x10\x5f\xcf\x98\x0e\x4f\xfd\x1f\xec\xa9\x2d\x2e\xde\xf5\x89\x38\xa6\xdf\xde\x90\x88\xd7\x00\x00\x00\xff\xff\x55\xe2\xdd\x2c\xbf\x01\x00\x00")
+var _migrations3_use_sequence_in_history_accountsSql = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\x9c\x91\x4d\x6b\xb3\x40\x14\x85\xf7\xf3\x2b\xce\x2e\xca\xfb\x66\x91\x6d\x5c\x4d\xc6\x1b\x22\x8c\x63\x3b\x5e\xdb\x64\x25\xa2\x43\x3a\x90\x6a\xeb\xd8\xaf\x7f\x5f\x48\xd3\x0f\x08\x6d\xa1\xcb\x73\x78\xe0\x39\xdc\x3b\x9f\xe3\xdf\xad\xdf\x8f\xcd\xe4\x50\xdd\x09\x65\x49\x32\xa1\xa4\xcb\x8a\x8c\x22\xdc\xf8\x30\x0d\xe3\x4b\xdd\xb4\xed\xf0\xd0\x4f\xa1\xf6\x5d\x1d\xdc\xbd\x00\x80\x92\xa5\x65\x5c\x67\xbc\xc1\xe2\x58\x64\x46\x59\xca\xc9\x30\x56\xbb\x53\x65\x0a\xe4\x99\xb9\x92\xba\xa2\x8f\x2c\xb7\x9f\x59\x49\xb5\x21\x2c\x12\x51\x92\x26\xc5\x08\x6e\x7a\x6c\x0e\xd1\xec\x1b\xef\xec\x3f\xa2\x13\x99\xcb\x6d\xe4\xbb\x18\x6b\x5b\xe4\x67\x33\xe3\x38\x11\x52\x33\x59\xb0\x5c\x69\x42\x61\xf4\xee\x0c\xc2\x1b\xa1\x0a\x5d\xe5\x06\xbe\x43\x49\x8c\x94\xd6\xb2\xd2\x8c\xde\x3d\xff\xbc\x64\xb9\x1c\xdd\xbe\x3d\x34\x21\xc4\x89\x10\x5f\xcf\x98\x0e\x4f\xfd\x1f\xec\xa9\x2d\x2e\xde\xf5\x89\x38\xa6\xdf\xde\x90\x88\xd7\x01\x00\x55\xe2\xdd\x2c\xbf\x01\x00\x00")
 
 func migrations3_use_sequence_in_history_accountsSqlBytes() ([]byte, error) {
 	return bindataRead(
@@ -153,7 +178,7 @@ func migrations3_use_sequence_in_history_accountsSql() (*asset, error) {
 	return a, nil
 }
 
-var _migrations4_add_protocol_versionSql = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\x84\xcd\xb1\x0a\xc2\x30\x10\x06\xe0\x3d\x4f\xf1\xef\x52\x70\xef\x14\x4d\x9d\xce\x44\x4a\x32\x38\x15\xd1\xa3\x06\x6a\xae\x5c\x82\xe2\xdb\xbb\xba\x88\x4f\xf0\x75\x1d\x36\x8f\x3c\xeb\xa5\x31\xd2\x6a\x2c\xc5\x61\x44\xb4\x3b\x1a\x10\x3c\x9d\x71\xcf\xb5\x89\xbe\xa7\x85\x6f\x33\x6b\x85\x01\xac\x73\xd8\x07\x4a\x47\x8f\x55\xa5\xc9\x55\x96\xe9\xc9\x5a\xb3\x14\xe4\xd2\x78\x66\x85\x1b\x0e\x36\x51\xc4\x16\x3e\x44\xf8\x44\xd4\x1b\xf3\x6d\x39\x79\x95\xff\x9a\x1b\xc3\xe9\x97\xd5\x9b\x4f\x00\x00\x00\xff\xff\x83\xbb\x30\x2e\xbc\x00\x00\x00")
+var _migrations4_add_protocol_versionSql = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\x84\xcd\xb1\x0a\xc2\x30\x10\x06\xe0\x3d\x4f\xf1\xef\x52\x70\xef\x14\x4d\x9d\xce\x44\x4a\x32\x38\x15\xd1\xa3\x06\x6a\xae\x5c\x82\xe2\xdb\xbb\xba\x88\x4f\xf0\x75\x1d\x36\x8f\x3c\xeb\xa5\x31\xd2\x6a\x2c\xc5\x61\x44\xb4\x3b\x1a\x10\x3c\x9d\x71\xcf\xb5\x89\xbe\xa7\x85\x6f\x33\x6b\x85\x01\xac\x73\xd8\x07\x4a\x47\x8f\x55\xa5\xc9\x55\x96\xe9\xc9\x5a\xb3\x14\xe4\xd2\x78\x66\x85\x1b\x0e\x36\x51\xc4\x16\x3e\x44\xf8\x44\xd4\x1b\xf3\x6d\x39\x79\x95\xff\x9a\x1b\xc3\xe9\x97\xd5\x9b\xcf\x00\x83\xbb\x30\x2e\xbc\x00\x00\x00")
 
 func migrations4_add_protocol_versionSqlBytes() ([]byte, error) {
 	return bindataRead(
@@ -173,7 +198,7 @@ func migrations4_add_protocol_versionSql() (*asset, error) {
 	return a, nil
 }
 
-var _migrations5_create_trades_tableSql = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\x94\x94\x51\x6f\xaa\x40\x10\x85\xdf\xf9\x15\x13\x9f\x30\x17\x93\x7b\x6f\x5a\x5f\x4c\x9a\x58\x25\xad\xa9\xc1\xd6\x4a\xd2\x37\xb2\xb0\x23\x6c\xa2\x2c\x99\x1d\xda\xf0\xef\x1b\x68\x69\x10\x57\xad\xaf\x9c\x39\x67\x38\xbb\x5f\x76\x34\x82\x3f\x7b\x95\x92\x60\x84\xb0\x70\x66\x6b\x7f\xba\xf1\x61\x33\xbd\x5f\xfa\x90\x29\xc3\x9a\xaa\x88\x49\x48\x34\xe0\x3a\x00\xf0\xf3\x51\x17\x48\x82\x95\xce\x23\x25\x21\x56\xa9\xca\x19\x82\xd5\x06\x82\x70\xb9\xf4\x9a\xc9\x81\x26\x89\x34\x00\x95\x33\xa6\x48\x1d\xb5\x91\xf5\x76\x8b\x64\x35\x37\xb2\xc1\xdd\xee\x84\x5e\xcb\x71\x59\x9d\x75\xeb\x9d\x8c\x84\x31\xc8\x11\x57\x05\x42\x92\x09\x12\x09\x23\xc1\xbb\xa0\x4a\xe5\xa9\x3b\xbe\x19\xf6\x22\x3b\x1e\x65\x4c\x89\x64\x71\xdd\x8e\xcf\xb8\x12\x2d\x6d\x9b\xfe\xfd\xb7\x7b\xf6\xba\xcc\xb9\xff\xff\x30\x7b\xf4\x67\x4f\xe0\x76\x47\xee\xe0\xef\xf0\xbb\x57\xac\xcb\x34\xe3\x6b\x9b\x1d\xb8\xae\xe8\x76\xe0\xfb\x75\xbb\xd6\x75\xb6\xdf\xe1\x50\xdd\xd0\x19\x4e\x9c\x96\xbf\x30\x58\xbc\x84\x3e\x2c\x82\xb9\xff\x06\x19\x93\x8c\x0a\x25\x61\x15\xf4\x91\x0c\x5f\x17\xc1\x03\xc4\x4c\x88\xe0\xda\xc8\xf4\x5a\x0a\x3b\xe1\x9d\xd4\xb8\x8a\x1a\x0c\x2f\x45\xb7\xac\xda\x52\xea\x90\xfa\xb6\x2e\x65\xf4\x90\xf4\xfa\xe4\x78\xc7\x00\x9e\x5a\xf7\x75\x78\x97\x16\x1e\xb1\xe2\x1d\x5f\xa8\x67\x63\xa3\x5e\xdb\x7d\x17\xe6\xfa\x23\x77\xe6\xeb\xd5\xb3\xfd\x5d\x48\x84\x49\x84\xc4\x89\xf3\x19\x00\x00\xff\xff\x79\x87\x24\x6b\x4c\x04\x00\x00")
+var _migrations5_create_trades_tableSql = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\x94\x94\x51\x6f\xaa\x40\x10\x85\xdf\xf9\x15\x13\x9f\x30\x17\x93\x7b\x6f\x5a\x5f\x4c\x9a\x58\x25\xad\xa9\xc1\xd6\x4a\xd2\x37\xb2\xb0\x23\x6c\xa2\x2c\x99\x1d\xda\xf0\xef\x1b\x68\x69\x10\x57\xad\xaf\x9c\x39\x67\x38\xbb\x5f\x76\x34\x82\x3f\x7b\x95\x92\x60\x84\xb0\x70\x66\x6b\x7f\xba\xf1\x61\x33\xbd\x5f\xfa\x90\x29\xc3\x9a\xaa\x88\x49\x48\x34\xe0\x3a\x00\xf0\xf3\x51\x17\x48\x82\x95\xce\x23\x25\x21\x56\xa9\xca\x19\x82\xd5\x06\x82\x70\xb9\xf4\x9a\xc9\x81\x26\x89\x34\x00\x95\x33\xa6\x48\x1d\xb5\x91\xf5\x76\x8b\x64\x35\x37\xb2\xc1\xdd\xee\x84\x5e\xcb\x71\x59\x9d\x75\xeb\x9d\x8c\x84\x31\xc8\x11\x57\x05\x42\x92\x09\x12\x09\x23\xc1\xbb\xa0\x4a\xe5\xa9\x3b\xbe\x19\xf6\x22\x3b\x1e\x65\x4c\x89\x64\x71\xdd\x8e\xcf\xb8\x12\x2d\x6d\x9b\xfe\xfd\xb7\x7b\xf6\xba\xcc\xb9\xff\xff\x30\x7b\xf4\x67\x4f\xe0\x76\x47\xee\xe0\xef\xf0\xbb\x57\xac\xcb\x34\xe3\x6b\x9b\x1d\xb8\xae\xe8\x76\xe0\xfb\x75\xbb\xd6\x75\xb6\xdf\xe1\x50\xdd\xd0\x19\x4e\x9c\x96\xbf\x30\x58\xbc\x84\x3e\x2c\x82\xb9\xff\x06\x19\x93\x8c\x0a\x25\x61\x15\xf4\x91\x0c\x5f\x17\xc1\x03\xc4\x4c\x88\xe0\xda\xc8\xf4\x5a\x0a\x3b\xe1\x9d\xd4\xb8\x8a\x1a\x0c\x2f\x45\xb7\xac\xda\x52\xea\x90\xfa\xb6\x2e\x65\xf4\x90\xf4\xfa\xe4\x78\xc7\x00\x9e\x5a\xf7\x75\x78\x97\x16\x1e\xb1\xe2\x1d\x5f\xa8\x67\x63\xa3\x5e\xdb\x7d\x17\xe6\xfa\x23\x77\xe6\xeb\xd5\xb3\xfd\x5d\x48\x84\x49\x84\xc4\x89\xf3\x39\x00\x79\x87\x24\x6b\x4c\x04\x00\x00")
 
 func migrations5_create_trades_tableSqlBytes() ([]byte, error) {
 	return bindataRead(
@@ -193,6 +218,86 @@ func migrations5_create_trades_tableSql() (*asset, error) {
 	return a, nil
 }
 
+var _migrations6_add_transaction_successfulSql = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\xd2\xd5\x55\xd0\xce\xcd\x4c\x2f\x4a\x2c\x49\x55\x08\x2d\xe0\xe2\x72\xf4\x09\x71\x0d\x52\x08\x71\x74\xf2\x71\x55\xf0\xf7\xf3\x89\x54\xc8\xc8\x2c\x2e\xc9\x2f\xaa\x8c\x2f\x29\x4a\xcc\x2b\x4e\x4c\x2e\xc9\xcc\xcf\x2b\xe6\x52\x50\x70\x74\x71\x51\x70\xf6\xf7\x09\xf5\xf5\x53\x28\x2e\x4d\x4e\x4e\x2d\x2e\x4e\x2b\xcd\x51\x48\xca\xcf\xcf\x49\x4d\xcc\xb3\xe6\xe2\x42\x36\xd7\x25\xbf\x3c\x8f\x48\x93\x15\x5c\x82\xfc\x03\x30\x0d\xb6\xe6\x02\x0c\x00\xce\x52\x55\x47\xa8\x00\x00\x00")
+
+func migrations6_add_transaction_successfulSqlBytes() ([]byte, error) {
+	return bindataRead(
+		_migrations6_add_transaction_successfulSql,
+		"migrations/6_add_transaction_successful.sql",
+	)
+}
+
+func migrations6_add_transaction_successfulSql() (*asset, error) {
+	bytes, err := migrations6_add_transaction_successfulSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "migrations/6_add_transaction_successful.sql", size: 168, mode: os.FileMode(420), modTime: time.Unix(1792314057, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _migrations7_create_reingest_progressSql = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\x7c\x90\xdd\x4a\xc3\x40\x10\x85\xef\xf7\x29\xce\x65\xc5\xf6\x09\x7a\x55\x4d\x90\x40\xd8\x68\xcd\x82\x77\xcb\x36\x19\xd6\x85\xee\x0f\xb3\xa3\x45\x9f\x5e\xaa\x28\x81\x52\xaf\x06\x66\xbe\xe1\xf0\x9d\xcd\x06\xb7\x31\x78\x76\x42\x30\x45\xdd\xef\xdb\xdd\xd8\x62\xdc\xdd\xf5\x2d\x98\x42\xf2\x54\xc5\x16\xce\x9e\xa9\x56\xac\x14\x00\x54\x71\x2c\xf6\x48\xb3\x27\x46\x48\x42\xe7\xa9\x87\x11\xda\xf4\xfd\xfa\x1b\xa1\x34\xff\x0f\x84\x58\x32\x0b\xb1\x7d\x27\xae\x21\xa7\x2b\xd8\x94\x63\x39\x92\xd0\x6c\x9d\x40\x42\xa4\x2a\x2e\x16\x9c\x82\xbc\xe6\xb7\x9f\x0d\x3e\x73\xa2\xbf\x37\x75\xb3\x55\xbf\x16\x46\x77\x4f\xa6\x45\xa7\x9b\xf6\xe5\x52\xc6\x1e\x3e\x2c\xbb\xe4\x09\x83\xbe\xbc\xc2\x3c\x77\xfa\x01\x07\x61\x22\xac\x96\xc6\xeb\x85\xdc\x39\x6c\xd9\x60\x93\x4f\x49\x35\xfb\xe1\xf1\x6a\x83\x93\xab\x93\x9b\x69\xab\xbe\x06\x00\x65\xb2\x24\x47\x79\x01\x00\x00")
+
+func migrations7_create_reingest_progressSqlBytes() ([]byte, error) {
+	return bindataRead(
+		_migrations7_create_reingest_progressSql,
+		"migrations/7_create_reingest_progress.sql",
+	)
+}
+
+func migrations7_create_reingest_progressSql() (*asset, error) {
+	bytes, err := migrations7_create_reingest_progressSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "migrations/7_create_reingest_progress.sql", size: 377, mode: os.FileMode(420), modTime: time.Unix(1792314995, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _migrations8_create_asset_statsSql = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\x8c\x93\x4f\x6f\xe2\x30\x10\xc5\xef\xf9\x14\x73\x23\x68\xe1\xc0\x6a\x97\x03\x39\x85\xc4\xbb\x44\x0a\x4e\x09\x76\x4b\x4f\x91\x71\xdc\xd4\x12\x18\x6a\x1b\x5a\xbe\x7d\x45\xf8\x13\x52\x22\xe0\x38\x33\xbf\x79\xcf\x79\x8e\xbb\x5d\xf8\xb5\x94\x85\x66\x56\x00\x5d\x3b\x41\x8a\x7c\x82\x60\x8a\x26\x14\xe1\x00\x01\x33\x46\xd8\xcc\x58\x66\x4d\x26\xf3\xcc\x88\x0f\x07\x00\x60\x4a\xfc\x94\xc0\x4b\x44\x46\xd0\x2b\x1b\x11\x0e\x52\x34\x46\x98\xc0\xf0\xf5\xd8\xc2\x09\x8c\x23\xfc\xec\xc7\x14\x9d\x6b\x7f\x56\xd5\x81\x1f\x8c\x10\xf4\x3c\xe7\x64\x4a\xfc\x61\x5c\x73\x04\xb7\x04\x65\x0e\x73\x59\x48\x65\x21\x44\xff\x7c\x1a\x13\x50\xe2\xcb\x6e\xd9\xc2\x6d\x5d\x1f\xaf\x35\x18\x68\x51\xf0\x05\x33\xa6\x0d\x38\x21\x80\x69\x1c\x77\x4a\x9d\x03\x6c\x77\x6b\x01\xfc\x9d\x69\xc6\xad\xd0\xb0\x65\x7a\x27\x55\xe1\xf6\xff\x34\xe3\x7c\x95\x37\xe1\xbd\xdf\xcd\xb8\x34\x66\x23\x74\xc3\xc2\xdf\xfe\xd5\xc2\x72\xb5\x51\xf6\xf4\x69\xf5\x99\xda\x2c\x33\xc6\xf9\x1e\x30\x20\x95\x15\x85\xd0\x3f\x90\xb7\x05\x2b\xae\x67\x4e\xbb\xca\x93\xe2\x68\x42\x11\x44\x38\x44\xb3\xda\x45\xce\x77\x99\xcc\x21\xc1\x97\x4d\xa0\xd3\x08\xff\x87\xb9\xd5\x42\x80\x2b\xf3\xb6\xf7\x88\x4c\x59\xde\x54\xaa\x42\xef\x5c\x24\xda\xa9\xc5\x55\x79\x35\x9a\xec\x17\x1e\xf0\xd8\x63\x77\x94\x8e\xb7\x73\x5f\xeb\x7c\x2e\xe7\xf2\x7d\x84\xab\x4f\xe5\x84\x69\xf2\xd4\xf0\xab\x72\x66\x38\xcb\x85\x77\x98\xdf\x78\x3f\x9e\xf3\x3d\x00\xa1\x2d\xe0\xe9\x73\x03\x00\x00")
+
+func migrations8_create_asset_statsSqlBytes() ([]byte, error) {
+	return bindataRead(
+		_migrations8_create_asset_statsSql,
+		"migrations/8_create_asset_stats.sql",
+	)
+}
+
+func migrations8_create_asset_statsSql() (*asset, error) {
+	bytes, err := migrations8_create_asset_statsSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "migrations/8_create_asset_stats.sql", size: 883, mode: os.FileMode(420), modTime: time.Unix(1792315572, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _migrations9_create_outboxSql = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\x94\x54\xc1\x72\x9b\x30\x10\xbd\xf3\x15\x7b\x8b\x3d\x4d\x0e\x69\x3b\x3d\xc4\x27\x82\xd5\x86\x19\x22\x1a\x07\xda\xf4\xc4\x08\xd8\x62\x75\xb0\x44\xa5\xc5\xc1\xfd\xfa\x0e\xa4\x09\xa0\x3a\x49\x7b\xd4\xee\xd3\x5b\xed\x7b\x6f\x74\x76\x06\x6f\x76\xb2\x32\x82\x10\xd2\xc6\x0b\x36\xcc\x4f\x18\xdc\xb2\x9b\x94\xf1\x80\x81\x6e\x29\xd7\x5d\x86\x7b\x54\x64\x33\x59\x66\x16\x7f\x7a\x00\x00\xb7\x89\xbf\x49\xe0\x6b\x98\x5c\xc1\xf9\x50\x08\x79\xb0\x61\xd7\x8c\x27\x70\xf9\xed\x4f\x89\xc7\x70\x1d\xf2\x2f\x7e\x94\xb2\xa7\xb3\x7f\x37\x9e\x03\x3f\xb8\x62\x70\xbe\xf2\x1e\xc7\x26\xfe\x65\xe4\xcc\x84\xc5\x00\x95\x25\xe4\xb2\x92\x8a\x60\xcd\x3e\xfa\x69\x94\x80\xc2\x8e\xf6\xa2\x5e\x9c\x1c\x7b\xe2\xc9\xc5\x85\xc1\xaa\xa8\x85\xb5\x4b\xe0\x71\x02\x3c\x8d\xa2\xd3\x81\x89\x0e\x0d\x42\xb1\x15\x46\x14\x84\x06\xf6\xc2\x1c\xa4\xaa\x16\xef\xde\xba\xc0\xad\xb4\xa4\xcd\x21\x1b\x47\xcf\xfb\x35\x96\x15\x9a\x7e\x5a\x8b\xaa\x40\x90\x8a\xb0\x42\xe3\xa0\x4a\x24\x21\x6b\x0b\x3f\xac\x56\xb9\xd3\x2b\x0c\x0a\xc2\x32\x13\x04\x24\x77\x68\x49\xec\x1a\xb8\x97\xb4\xd5\xed\x43\x05\x7e\x69\x85\xee\xfb\xbb\xbf\xb5\xe8\x6b\x59\xd1\x1a\x83\x8a\x16\xee\x1e\x06\xa5\xaa\xd0\x12\x96\x90\x6b\x5d\xa3\x50\x4f\xf7\xbe\x8b\xda\x8e\xfc\xde\x72\x74\x22\xe5\xe1\x4d\xca\x20\xe4\x6b\x76\xe7\x84\x20\x1f\x24\x89\xf9\xbc\x0c\xe9\x6d\xc8\x3f\x41\x4e\x06\x11\x16\xb2\x5c\xae\xfe\x8d\x8a\xba\x57\xc9\x7a\xc8\x29\x4c\x29\x9f\xe1\x9a\x38\xf6\x32\xe3\x08\x5c\xba\xe1\xc3\xae\xd1\x86\x7a\x31\xad\x36\x8f\xe9\x53\x62\x77\x2c\x33\x1f\xde\xbb\x5a\xd7\xc2\xd2\xc3\xc4\x67\x63\xd3\x36\xe5\xff\x9b\x3e\xf0\x4e\x9d\x7f\xd5\xb3\xf9\x1e\xbd\x3a\xc3\x12\x31\x77\x3a\x73\x61\x7a\x4c\xcf\x38\xfd\x15\xd6\xfa\x5e\x79\xeb\x4d\xfc\xf9\xb8\x42\x85\xb0\x85\x28\x71\x35\x85\xcc\xa5\x9f\x23\x5e\xfc\x5a\x56\xde\xef\x01\x00\x45\xcc\x5a\x3e\x90\x04\x00\x00")
+
+func migrations9_create_outboxSqlBytes() ([]byte, error) {
+	return bindataRead(
+		_migrations9_create_outboxSql,
+		"migrations/9_create_outbox.sql",
+	)
+}
+
+func migrations9_create_outboxSql() (*asset, error) {
+	bytes, err := migrations9_create_outboxSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "migrations/9_create_outbox.sql", size: 1168, mode: os.FileMode(420), modTime: time.Unix(1792322058, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
 // Asset loads and returns the asset for the given name.
 // It returns an error if the asset could not be found or
 // could not be loaded.
@@ -246,11 +351,16 @@ func AssetNames() []string {
 // _bindata is a table, holding each asset generator, mapped to its name.
 var _bindata = map[string]func() (*asset, error){
 	"latest.sql": latestSql,
+	"migrations/10_index_effects_by_offer_id.sql": migrations10_index_effects_by_offer_idSql,
 	"migrations/1_initial_schema.sql": migrations1_initial_schemaSql,
 	"migrations/2_index_participants_by_toid.sql": migrations2_index_participants_by_toidSql,
 	"migrations/3_use_sequence_in_history_accounts.sql": migrations3_use_sequence_in_history_accountsSql,
 	"migrations/4_add_protocol_version.sql": migrations4_add_protocol_versionSql,
 	"migrations/5_create_trades_table.sql": migrations5_create_trades_tableSql,
+	"migrations/6_add_transaction_successful.sql": migrations6_add_transaction_successfulSql,
+	"migrations/7_create_reingest_progress.sql": migrations7_create_reingest_progressSql,
+	"migrations/8_create_asset_stats.sql": migrations8_create_asset_statsSql,
+	"migrations/9_create_outbox.sql": migrations9_create_outboxSql,
 }
 
 // AssetDir returns the file names below a certain
@@ -295,11 +405,16 @@ type bintree struct {
 var _bintree = &bintree{nil, map[string]*bintree{
 	"latest.sql": &bintree{latestSql, map[string]*bintree{}},
 	"migrations": &bintree{nil, map[string]*bintree{
+		"10_index_effects_by_offer_id.sql": &bintree{migrations10_index_effects_by_offer_idSql, map[string]*bintree{}},
 		"1_initial_schema.sql": &bintree{migrations1_initial_schemaSql, map[string]*bintree{}},
 		"2_index_participants_by_toid.sql": &bintree{migrations2_index_participants_by_toidSql, map[string]*bintree{}},
 		"3_use_sequence_in_history_accounts.sql": &bintree{migrations3_use_sequence_in_history_accountsSql, map[string]*bintree{}},
 		"4_add_protocol_version.sql": &bintree{migrations4_add_protocol_versionSql, map[string]*bintree{}},
 		"5_create_trades_table.sql": &bintree{migrations5_create_trades_tableSql, map[string]*bintree{}},
+		"6_add_transaction_successful.sql": &bintree{migrations6_add_transaction_successfulSql, map[string]*bintree{}},
+		"7_create_reingest_progress.sql": &bintree{migrations7_create_reingest_progressSql, map[string]*bintree{}},
+		"8_create_asset_stats.sql": &bintree{migrations8_create_asset_statsSql, map[string]*bintree{}},
+		"9_create_outbox.sql": &bintree{migrations9_create_outboxSql, map[string]*bintree{}},
 	}},
 }}
 
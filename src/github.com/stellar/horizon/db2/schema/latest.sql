@@ -18,6 +18,45 @@ SET default_tablespace = '';
 
 SET default_with_oids = false;
 
+--
+-- Name: asset_stats_id_seq; Type: SEQUENCE; Schema: public; Owner: -
+--
+
+CREATE SEQUENCE asset_stats_id_seq
+    START WITH 1
+    INCREMENT BY 1
+    NO MINVALUE
+    NO MAXVALUE
+    CACHE 1;
+
+
+--
+-- Name: asset_stats; Type: TABLE; Schema: public; Owner: -
+--
+
+CREATE TABLE asset_stats (
+    id bigint DEFAULT nextval('asset_stats_id_seq'::regclass) NOT NULL,
+    asset_type character varying(64) NOT NULL,
+    asset_code character varying(12) NOT NULL,
+    asset_issuer character varying(56) NOT NULL,
+    amount bigint NOT NULL,
+    num_accounts integer NOT NULL,
+    flags integer NOT NULL
+);
+
+
+--
+-- Name: export_cursors; Type: TABLE; Schema: public; Owner: -
+--
+
+CREATE TABLE export_cursors (
+    name character varying(64) NOT NULL,
+    last_event_id bigint NOT NULL,
+    updated_at timestamp without time zone NOT NULL,
+    last_txid bigint NOT NULL
+);
+
+
 --
 -- Name: gorp_migrations; Type: TABLE; Schema: public; Owner: -
 --
@@ -206,7 +245,8 @@ CREATE TABLE history_transactions (
     signatures character varying(96)[] DEFAULT '{}'::character varying[] NOT NULL,
     memo_type character varying DEFAULT 'none'::character varying NOT NULL,
     memo character varying,
-    time_bounds int8range
+    time_bounds int8range,
+    successful boolean
 );
 
 
@@ -224,6 +264,46 @@ ALTER TABLE ONLY history_operation_participants ALTER COLUMN id SET DEFAULT next
 ALTER TABLE ONLY history_transaction_participants ALTER COLUMN id SET DEFAULT nextval('history_transaction_participants_id_seq'::regclass);
 
 
+--
+-- Name: outbox_events_id_seq; Type: SEQUENCE; Schema: public; Owner: -
+--
+
+CREATE SEQUENCE outbox_events_id_seq
+    START WITH 1
+    INCREMENT BY 1
+    NO MINVALUE
+    NO MAXVALUE
+    CACHE 1;
+
+
+--
+-- Name: outbox_events; Type: TABLE; Schema: public; Owner: -
+--
+
+CREATE TABLE outbox_events (
+    id bigint DEFAULT nextval('outbox_events_id_seq'::regclass) NOT NULL,
+    type character varying(32) NOT NULL,
+    history_id bigint NOT NULL,
+    ledger_sequence integer NOT NULL,
+    details jsonb NOT NULL,
+    created_at timestamp without time zone NOT NULL,
+    txid bigint DEFAULT txid_current() NOT NULL,
+    reingested boolean DEFAULT false NOT NULL
+);
+
+
+--
+-- Name: reingest_progress; Type: TABLE; Schema: public; Owner: -
+--
+
+CREATE TABLE reingest_progress (
+    start_ledger integer NOT NULL,
+    end_ledger integer NOT NULL,
+    importer_version integer NOT NULL,
+    completed_at timestamp without time zone NOT NULL
+);
+
+
 --
 -- Data for Name: gorp_migrations; Type: TABLE DATA; Schema: public; Owner: -
 --
@@ -233,6 +313,11 @@ INSERT INTO gorp_migrations VALUES ('2_index_participants_by_toid.sql', '2017-07
 INSERT INTO gorp_migrations VALUES ('3_use_sequence_in_history_accounts.sql', '2017-07-26 15:58:25.371774-05');
 INSERT INTO gorp_migrations VALUES ('4_add_protocol_version.sql', '2017-07-26 15:58:25.377059-05');
 INSERT INTO gorp_migrations VALUES ('5_create_trades_table.sql', '2017-07-26 15:58:25.381594-05');
+INSERT INTO gorp_migrations VALUES ('6_add_transaction_successful.sql', '2017-07-26 15:58:25.381594-05');
+INSERT INTO gorp_migrations VALUES ('7_create_reingest_progress.sql', '2017-07-26 15:58:25.381594-05');
+INSERT INTO gorp_migrations VALUES ('8_create_asset_stats.sql', '2017-07-26 15:58:25.381594-05');
+INSERT INTO gorp_migrations VALUES ('9_create_outbox.sql', '2017-07-26 15:58:25.381594-05');
+INSERT INTO gorp_migrations VALUES ('10_index_effects_by_offer_id.sql', '2017-07-26 15:58:25.381594-05');
 
 
 --
@@ -447,6 +532,13 @@ CREATE UNIQUE INDEX index_history_accounts_on_address ON history_accounts USING
 CREATE UNIQUE INDEX index_history_accounts_on_id ON history_accounts USING btree (id);
 
 
+--
+-- Name: index_history_effects_on_offer_id; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE INDEX index_history_effects_on_offer_id ON history_effects USING btree ((((details ->> 'offer_id'::text))::bigint));
+
+
 --
 -- Name: index_history_effects_on_type; Type: INDEX; Schema: public; Owner: -
 --
@@ -531,6 +623,69 @@ CREATE UNIQUE INDEX index_history_transactions_on_id ON history_transactions USI
 CREATE INDEX trade_effects_by_order_book ON history_effects USING btree (((details ->> 'sold_asset_type'::text)), ((details ->> 'sold_asset_code'::text)), ((details ->> 'sold_asset_issuer'::text)), ((details ->> 'bought_asset_type'::text)), ((details ->> 'bought_asset_code'::text)), ((details ->> 'bought_asset_issuer'::text))) WHERE (type = 33);
 
 
+--
+-- Name: reingest_progress_by_range; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE UNIQUE INDEX reingest_progress_by_range ON reingest_progress USING btree (start_ledger, end_ledger);
+
+
+--
+-- Name: asset_stats_by_asset; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE UNIQUE INDEX asset_stats_by_asset ON asset_stats USING btree (asset_type, asset_code, asset_issuer);
+
+
+--
+-- Name: asset_stats_by_code; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE INDEX asset_stats_by_code ON asset_stats USING btree (asset_code);
+
+
+--
+-- Name: asset_stats_by_id; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE UNIQUE INDEX asset_stats_by_id ON asset_stats USING btree (id);
+
+
+--
+-- Name: asset_stats_by_issuer; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE INDEX asset_stats_by_issuer ON asset_stats USING btree (asset_issuer);
+
+
+--
+-- Name: export_cursors_by_name; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE UNIQUE INDEX export_cursors_by_name ON export_cursors USING btree (name);
+
+
+--
+-- Name: outbox_events_by_history_id; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE INDEX outbox_events_by_history_id ON outbox_events USING btree (history_id);
+
+
+--
+-- Name: outbox_events_by_id; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE UNIQUE INDEX outbox_events_by_id ON outbox_events USING btree (id);
+
+
+--
+-- Name: outbox_events_by_txid; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE UNIQUE INDEX outbox_events_by_txid ON outbox_events USING btree (txid, id);
+
+
 --
 -- PostgreSQL database dump complete
 --
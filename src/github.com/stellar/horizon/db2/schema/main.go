@@ -34,6 +34,10 @@ func Init(db *db.Session) error {
 	return db.ExecAll(string(MustAsset("latest.sql")))
 }
 
+// DefaultMigrationTable is the table that records which of Migrations have
+// been applied.
+const DefaultMigrationTable = "gorp_migrations"
+
 // Migrate performs schema migration.  Migrations can occur in one of three
 // ways:
 //
@@ -47,23 +51,34 @@ func Init(db *db.Session) error {
 // upward back to the current version at the start of the process. If count is
 // 0, a count of 1 will be assumed.
 func Migrate(db *sql.DB, dir MigrateDir, count int) (int, error) {
+	return MigrateSource(db, Migrations, DefaultMigrationTable, dir, count)
+}
+
+// MigrateSource performs schema migration of the migrations in `source`, in
+// the same way as Migrate, recording the applied migrations in `table`.  It
+// is used to migrate the tables of ingestion processors independently of
+// horizon's own.
+func MigrateSource(db *sql.DB, source migrate.MigrationSource, table string, dir MigrateDir, count int) (int, error) {
+	migrate.SetTable(table)
+	defer migrate.SetTable(DefaultMigrationTable)
+
 	switch dir {
 	case MigrateUp:
-		return migrate.ExecMax(db, "postgres", Migrations, migrate.Up, count)
+		return migrate.ExecMax(db, "postgres", source, migrate.Up, count)
 	case MigrateDown:
-		return migrate.ExecMax(db, "postgres", Migrations, migrate.Down, count)
+		return migrate.ExecMax(db, "postgres", source, migrate.Down, count)
 	case MigrateRedo:
 
 		if count == 0 {
 			count = 1
 		}
 
-		down, err := migrate.ExecMax(db, "postgres", Migrations, migrate.Down, count)
+		down, err := migrate.ExecMax(db, "postgres", source, migrate.Down, count)
 		if err != nil {
 			return down, err
 		}
 
-		return migrate.ExecMax(db, "postgres", Migrations, migrate.Up, down)
+		return migrate.ExecMax(db, "postgres", source, migrate.Up, down)
 	default:
 		return 0, errors.New("Invalid migration direction")
 	}
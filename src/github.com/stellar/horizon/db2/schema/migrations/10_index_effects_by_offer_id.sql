@@ -0,0 +1,5 @@
+-- +migrate Up
+CREATE INDEX index_history_effects_on_offer_id ON history_effects USING btree (((details->>'offer_id')::bigint));
+
+-- +migrate Down
+DROP INDEX index_history_effects_on_offer_id;
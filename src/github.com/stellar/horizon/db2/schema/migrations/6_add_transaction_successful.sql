@@ -0,0 +1,8 @@
+-- +migrate Up
+
+ALTER TABLE ONLY history_transactions
+  ADD COLUMN successful boolean;
+
+-- +migrate Down
+
+ALTER TABLE ONLY history_transactions DROP COLUMN successful;
@@ -0,0 +1,12 @@
+-- +migrate Up
+CREATE TABLE reingest_progress (
+    start_ledger integer NOT NULL,
+    end_ledger integer NOT NULL,
+    importer_version integer NOT NULL,
+    completed_at timestamp without time zone NOT NULL
+);
+
+CREATE UNIQUE INDEX reingest_progress_by_range ON reingest_progress USING btree (start_ledger, end_ledger);
+
+-- +migrate Down
+DROP TABLE reingest_progress cascade;
@@ -0,0 +1,26 @@
+-- +migrate Up
+CREATE SEQUENCE asset_stats_id_seq
+    START WITH 1
+    INCREMENT BY 1
+    NO MINVALUE
+    NO MAXVALUE
+    CACHE 1;
+
+CREATE TABLE asset_stats (
+    id bigint DEFAULT nextval('asset_stats_id_seq'::regclass) NOT NULL,
+    asset_type character varying(64) NOT NULL,
+    asset_code character varying(12) NOT NULL,
+    asset_issuer character varying(56) NOT NULL,
+    amount bigint NOT NULL,
+    num_accounts integer NOT NULL,
+    flags integer NOT NULL
+);
+
+CREATE UNIQUE INDEX asset_stats_by_id ON asset_stats USING btree (id);
+CREATE UNIQUE INDEX asset_stats_by_asset ON asset_stats USING btree (asset_type, asset_code, asset_issuer);
+CREATE INDEX asset_stats_by_code ON asset_stats USING btree (asset_code);
+CREATE INDEX asset_stats_by_issuer ON asset_stats USING btree (asset_issuer);
+
+-- +migrate Down
+DROP TABLE asset_stats cascade;
+DROP SEQUENCE asset_stats_id_seq;
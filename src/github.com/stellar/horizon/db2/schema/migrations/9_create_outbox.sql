@@ -0,0 +1,36 @@
+-- +migrate Up
+CREATE SEQUENCE outbox_events_id_seq
+    START WITH 1
+    INCREMENT BY 1
+    NO MINVALUE
+    NO MAXVALUE
+    CACHE 1;
+
+CREATE TABLE outbox_events (
+    id bigint DEFAULT nextval('outbox_events_id_seq'::regclass) NOT NULL,
+    type character varying(32) NOT NULL,
+    history_id bigint NOT NULL,
+    ledger_sequence integer NOT NULL,
+    details jsonb NOT NULL,
+    created_at timestamp without time zone NOT NULL,
+    txid bigint DEFAULT txid_current() NOT NULL,
+    reingested boolean DEFAULT false NOT NULL
+);
+
+CREATE UNIQUE INDEX outbox_events_by_id ON outbox_events USING btree (id);
+CREATE UNIQUE INDEX outbox_events_by_txid ON outbox_events USING btree (txid, id);
+CREATE INDEX outbox_events_by_history_id ON outbox_events USING btree (history_id);
+
+CREATE TABLE export_cursors (
+    name character varying(64) NOT NULL,
+    last_event_id bigint NOT NULL,
+    updated_at timestamp without time zone NOT NULL,
+    last_txid bigint NOT NULL
+);
+
+CREATE UNIQUE INDEX export_cursors_by_name ON export_cursors USING btree (name);
+
+-- +migrate Down
+DROP TABLE export_cursors cascade;
+DROP TABLE outbox_events cascade;
+DROP SEQUENCE outbox_events_id_seq;
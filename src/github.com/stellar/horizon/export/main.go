@@ -0,0 +1,194 @@
+// Package export delivers the events written to the `outbox_events` table by
+// the ingestion system to an external sink, such as a file or an http
+// endpoint, tracking which events each export has delivered in the
+// `export_cursors` table.
+package export
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/stellar/go/support/db"
+	"github.com/stellar/go/support/errors"
+	"github.com/stellar/horizon/db2/history"
+	"github.com/stellar/horizon/log"
+	"golang.org/x/net/context"
+)
+
+const (
+	// DefaultBatchSize is the default number of events delivered to a sink
+	// at once.
+	DefaultBatchSize = 100
+
+	// DefaultPollInterval is the default time an Exporter waits for new events
+	// once it has delivered every event in the outbox.
+	DefaultPollInterval = 1 * time.Second
+)
+
+// Event is an event from the outbox, as delivered to a sink.  Events are
+// delivered ordered by TxID, the id of the database transaction that wrote
+// them, then by ID.
+//
+// Reingested is true for the events written when a ledger is ingested again,
+// for example by `horizon db reingest`.  Such an event describes the same
+// record as an earlier event of the same Type and HistoryID (and, for effects
+// and trades, the same order in Details), and supersedes it.
+type Event struct {
+	ID         int64           `json:"id"`
+	TxID       int64           `json:"txid"`
+	Type       string          `json:"type"`
+	HistoryID  int64           `json:"history_id"`
+	Ledger     int32           `json:"ledger"`
+	Details    json.RawMessage `json:"details"`
+	CreatedAt  time.Time       `json:"created_at"`
+	Reingested bool            `json:"reingested"`
+}
+
+// Sink receives the events delivered by an Exporter.
+type Sink interface {
+	// Deliver sends `events`, in delivery order, to the sink.  A nil result means
+	// that every event has been durably received; on error the same events
+	// will be delivered again.
+	Deliver(events []Event) error
+}
+
+// Resumer is implemented by sinks that can report the position of the last
+// event they durably received.  An Exporter uses it to avoid delivering events
+// a second time when it stopped after delivering them but before recording its
+// cursor.
+type Resumer interface {
+	LastDelivered() (history.OutboxPosition, error)
+}
+
+// Position returns the position of the event in delivery order.
+func (event *Event) Position() history.OutboxPosition {
+	return history.OutboxPosition{TxID: event.TxID, EventID: event.ID}
+}
+
+// Exporter tails the outbox, delivering each event to Sink exactly once.
+// Only one Exporter should run for each Name at a time.
+type Exporter struct {
+	// DB is the horizon database the outbox is read from.
+	DB *db.Session
+
+	// Name identifies the export's cursor in the `export_cursors` table.
+	Name string
+
+	Sink Sink
+
+	BatchSize    uint64
+	PollInterval time.Duration
+}
+
+// New returns an exporter that delivers the events in the outbox of `horizon`
+// to `sink`, using the cursor named `name`.
+func New(name string, sink Sink, horizon *db.Session) *Exporter {
+	return &Exporter{
+		DB:           horizon,
+		Name:         name,
+		Sink:         sink,
+		BatchSize:    DefaultBatchSize,
+		PollInterval: DefaultPollInterval,
+	}
+}
+
+// Run delivers events until `ctx` is done.  Failed deliveries are logged and
+// retried after PollInterval.
+func (e *Exporter) Run(ctx context.Context) {
+	for {
+		delivered, err := e.ExportOnce()
+		if err != nil {
+			log.WithField("export", e.Name).WithStack(err).Error(err)
+		}
+
+		if delivered > 0 && err == nil {
+			continue
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(e.PollInterval):
+		}
+	}
+}
+
+// ExportOnce delivers the next batch of events to the sink and advances the
+// export's cursor, returning the number of events delivered.
+func (e *Exporter) ExportOnce() (int, error) {
+	q := &history.Q{Session: e.DB}
+
+	cursor, err := e.cursor(q)
+	if err != nil {
+		return 0, err
+	}
+
+	var rows []history.OutboxEvent
+	err = q.OutboxEventsAfter(&rows, cursor, e.BatchSize)
+	if err != nil {
+		return 0, errors.Wrap(err, "failed to load outbox events")
+	}
+
+	if len(rows) == 0 {
+		return 0, nil
+	}
+
+	events := make([]Event, len(rows))
+	for i, row := range rows {
+		events[i] = Event{
+			ID:         row.ID,
+			TxID:       row.TxID,
+			Type:       row.Type,
+			HistoryID:  row.HistoryID,
+			Ledger:     row.LedgerSequence,
+			Details:    json.RawMessage(row.Details),
+			CreatedAt:  row.CreatedAt,
+			Reingested: row.Reingested,
+		}
+	}
+
+	err = e.Sink.Deliver(events)
+	if err != nil {
+		return 0, errors.Wrap(err, "failed to deliver events")
+	}
+
+	err = q.UpdateExportCursor(e.Name, events[len(events)-1].Position())
+	if err != nil {
+		return len(events), errors.Wrap(err, "failed to update export cursor")
+	}
+
+	return len(events), nil
+}
+
+// cursor returns the position of the last event delivered to the sink.
+func (e *Exporter) cursor(q *history.Q) (history.OutboxPosition, error) {
+	var cursor history.OutboxPosition
+	err := q.ExportCursor(&cursor, e.Name)
+	if err != nil {
+		return cursor, errors.Wrap(err, "failed to load export cursor")
+	}
+
+	r, ok := e.Sink.(Resumer)
+	if !ok {
+		return cursor, nil
+	}
+
+	last, err := r.LastDelivered()
+	if err != nil {
+		return cursor, errors.Wrap(err, "failed to load the last event delivered to the sink")
+	}
+
+	if after(last, cursor) {
+		cursor = last
+	}
+
+	return cursor, nil
+}
+
+// after returns whether `a` follows `b` in delivery order.
+func after(a, b history.OutboxPosition) bool {
+	if a.TxID != b.TxID {
+		return a.TxID > b.TxID
+	}
+	return a.EventID > b.EventID
+}
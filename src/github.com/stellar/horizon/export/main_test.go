@@ -0,0 +1,145 @@
+package export
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stellar/go/support/db"
+	"github.com/stellar/horizon/db2/history"
+	"github.com/stellar/horizon/test"
+	"github.com/stretchr/testify/assert"
+)
+
+// memorySink is a Sink that records the events delivered to it.
+type memorySink struct {
+	events []Event
+}
+
+func (sink *memorySink) Deliver(events []Event) error {
+	sink.events = append(sink.events, events...)
+	return nil
+}
+
+func (sink *memorySink) ids() (result []int64) {
+	for _, event := range sink.events {
+		result = append(result, event.ID)
+	}
+	return
+}
+
+// insertEvent writes an outbox event with id `id` using `session`.
+func insertEvent(tt *test.T, session *db.Session, id int64) {
+	_, err := session.ExecRaw(
+		`INSERT INTO outbox_events (id, type, history_id, ledger_sequence, details, created_at)
+		VALUES (?, 'ledger_closed', 0, 1, '{}', ?)`,
+		id, time.Now().UTC(),
+	)
+	tt.Require.NoError(err)
+}
+
+// clearOutbox removes the events and cursors loaded by the scenario.
+func clearOutbox(tt *test.T) *db.Session {
+	hdb := tt.HorizonSession()
+	_, err := hdb.ExecRaw("DELETE FROM outbox_events")
+	tt.Require.NoError(err)
+	_, err = hdb.ExecRaw("DELETE FROM export_cursors")
+	tt.Require.NoError(err)
+	return hdb
+}
+
+func TestExporter(t *testing.T) {
+	tt := test.Start(t).Scenario("base")
+	defer tt.Finish()
+	hdb := clearOutbox(tt)
+
+	insertEvent(tt, hdb, 1)
+	insertEvent(tt, hdb, 2)
+	insertEvent(tt, hdb, 3)
+
+	sink := &memorySink{}
+	e := New("test", sink, hdb)
+	e.BatchSize = 2
+
+	n, err := e.ExportOnce()
+	tt.Require.NoError(err)
+	tt.Assert.Equal(2, n)
+
+	n, err = e.ExportOnce()
+	tt.Require.NoError(err)
+	tt.Assert.Equal(1, n)
+
+	n, err = e.ExportOnce()
+	tt.Require.NoError(err)
+	tt.Assert.Equal(0, n)
+
+	// a new exporter with the same name resumes from the stored cursor
+	insertEvent(tt, hdb, 4)
+	e = New("test", sink, hdb)
+	n, err = e.ExportOnce()
+	tt.Require.NoError(err)
+	tt.Assert.Equal(1, n)
+	tt.Assert.Equal([]int64{1, 2, 3, 4}, sink.ids())
+
+	// a different name starts from the beginning
+	other := &memorySink{}
+	n, err = New("other", other, hdb).ExportOnce()
+	tt.Require.NoError(err)
+	tt.Assert.Equal(4, n)
+}
+
+func TestExporter_InProgressTransaction(t *testing.T) {
+	tt := test.Start(t).Scenario("base")
+	defer tt.Finish()
+	hdb := clearOutbox(tt)
+
+	insertEvent(tt, hdb, 1)
+
+	// an ingestion that has written events but not yet committed
+	pending := hdb.Clone()
+	tt.Require.NoError(pending.Begin())
+	defer pending.Rollback()
+	insertEvent(tt, pending, 2)
+
+	// events committed after it began writing wait for it to end
+	insertEvent(tt, hdb, 3)
+
+	sink := &memorySink{}
+	e := New("test", sink, hdb)
+
+	n, err := e.ExportOnce()
+	tt.Require.NoError(err)
+	tt.Assert.Equal(1, n)
+	tt.Assert.Equal([]int64{1}, sink.ids())
+
+	tt.Require.NoError(pending.Commit())
+
+	n, err = e.ExportOnce()
+	tt.Require.NoError(err)
+	tt.Assert.Equal(2, n)
+	tt.Assert.Equal([]int64{1, 2, 3}, sink.ids())
+
+	// an event written by a rolled back transaction is never delivered, and
+	// does not hold back the events that follow it
+	aborted := hdb.Clone()
+	tt.Require.NoError(aborted.Begin())
+	insertEvent(tt, aborted, 4)
+	tt.Require.NoError(aborted.Rollback())
+	insertEvent(tt, hdb, 5)
+
+	n, err = e.ExportOnce()
+	tt.Require.NoError(err)
+	tt.Assert.Equal(1, n)
+	tt.Assert.Equal([]int64{1, 2, 3, 5}, sink.ids())
+}
+
+func TestAfter(t *testing.T) {
+	pos := func(txid, id int64) history.OutboxPosition {
+		return history.OutboxPosition{TxID: txid, EventID: id}
+	}
+
+	assert.True(t, after(pos(2, 1), pos(1, 5)))
+	assert.True(t, after(pos(1, 6), pos(1, 5)))
+	assert.False(t, after(pos(1, 5), pos(1, 5)))
+	assert.False(t, after(pos(1, 9), pos(2, 1)))
+	assert.False(t, after(pos(0, 0), pos(1, 1)))
+}
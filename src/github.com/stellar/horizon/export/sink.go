@@ -0,0 +1,219 @@
+package export
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+
+	"github.com/stellar/go/support/errors"
+	"github.com/stellar/horizon/db2/history"
+)
+
+// NewSink returns the sink described by `target`.  An http or https url
+// returns an HTTPSink posting to it, while a file url or a path returns a
+// FileSink appending to the file.
+func NewSink(target string) (Sink, error) {
+	u, err := url.Parse(target)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to parse sink")
+	}
+
+	switch u.Scheme {
+	case "http", "https":
+		return &HTTPSink{URL: target, Client: http.DefaultClient}, nil
+	case "file":
+		return NewFileSink(u.Path)
+	case "":
+		return NewFileSink(target)
+	default:
+		return nil, fmt.Errorf("unknown sink scheme %q", u.Scheme)
+	}
+}
+
+// FileSink is a Sink that appends each event to a file as a line of JSON.
+type FileSink struct {
+	file *os.File
+}
+
+// NewFileSink opens the file at `path` for appending events, creating it if
+// needed.  A partially written line left at the end of the file by an
+// interrupted delivery is removed.
+func NewFileSink(path string) (*FileSink, error) {
+	file, err := os.OpenFile(path, os.O_RDWR|os.O_CREATE, 0644)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to open file")
+	}
+
+	sink := &FileSink{file: file}
+
+	end, err := sink.completeLength()
+	if err != nil {
+		file.Close()
+		return nil, err
+	}
+
+	err = file.Truncate(end)
+	if err != nil {
+		file.Close()
+		return nil, errors.Wrap(err, "failed to truncate partial line")
+	}
+
+	_, err = file.Seek(end, io.SeekStart)
+	if err != nil {
+		file.Close()
+		return nil, errors.Wrap(err, "failed to seek to end of file")
+	}
+
+	return sink, nil
+}
+
+// Deliver implements Sink.  The file is synced before returning.
+func (sink *FileSink) Deliver(events []Event) error {
+	w := bufio.NewWriter(sink.file)
+	enc := json.NewEncoder(w)
+
+	for _, event := range events {
+		err := enc.Encode(event)
+		if err != nil {
+			return errors.Wrap(err, "failed to encode event")
+		}
+	}
+
+	err := w.Flush()
+	if err != nil {
+		return errors.Wrap(err, "failed to write events")
+	}
+
+	return sink.file.Sync()
+}
+
+// LastDelivered implements Resumer by reading the position of the event on
+// the last line of the file.
+func (sink *FileSink) LastDelivered() (history.OutboxPosition, error) {
+	end, err := sink.completeLength()
+	if err != nil {
+		return history.OutboxPosition{}, err
+	}
+
+	if end == 0 {
+		return history.OutboxPosition{}, nil
+	}
+
+	line, err := sink.lineBefore(end - 1)
+	if err != nil {
+		return history.OutboxPosition{}, err
+	}
+
+	var event Event
+	err = json.Unmarshal(line, &event)
+	if err != nil {
+		return history.OutboxPosition{}, errors.Wrap(err, "failed to decode last event")
+	}
+
+	return event.Position(), nil
+}
+
+// Close closes the file.
+func (sink *FileSink) Close() error {
+	return sink.file.Close()
+}
+
+// completeLength returns the length of the file up to and including its last
+// newline.
+func (sink *FileSink) completeLength() (int64, error) {
+	info, err := sink.file.Stat()
+	if err != nil {
+		return 0, errors.Wrap(err, "failed to stat file")
+	}
+
+	size := info.Size()
+	buf := make([]byte, 4096)
+
+	for end := size; end > 0; {
+		start := end - int64(len(buf))
+		if start < 0 {
+			start = 0
+		}
+
+		chunk := buf[:end-start]
+		_, err := sink.file.ReadAt(chunk, start)
+		if err != nil {
+			return 0, errors.Wrap(err, "failed to read file")
+		}
+
+		if i := bytes.LastIndexByte(chunk, '\n'); i != -1 {
+			return start + int64(i) + 1, nil
+		}
+
+		end = start
+	}
+
+	return 0, nil
+}
+
+// lineBefore returns the line that ends at offset `end`, exclusive of the
+// newline at `end`.
+func (sink *FileSink) lineBefore(end int64) ([]byte, error) {
+	var line []byte
+	buf := make([]byte, 4096)
+
+	for end > 0 {
+		start := end - int64(len(buf))
+		if start < 0 {
+			start = 0
+		}
+
+		chunk := buf[:end-start]
+		_, err := sink.file.ReadAt(chunk, start)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to read file")
+		}
+
+		if i := bytes.LastIndexByte(chunk, '\n'); i != -1 {
+			return append(append([]byte{}, chunk[i+1:]...), line...), nil
+		}
+
+		line = append(append([]byte{}, chunk...), line...)
+		end = start
+	}
+
+	return line, nil
+}
+
+// HTTPSink is a Sink that posts each batch of events to URL as a JSON array.
+// A batch is delivered when the endpoint responds with a 2xx status.  As an
+// endpoint can process a batch without its response being received, it
+// should ignore events that do not follow the last it processed, comparing
+// their txid then their id.
+type HTTPSink struct {
+	URL    string
+	Client *http.Client
+}
+
+// Deliver implements Sink
+func (sink *HTTPSink) Deliver(events []Event) error {
+	body, err := json.Marshal(events)
+	if err != nil {
+		return errors.Wrap(err, "failed to encode events")
+	}
+
+	resp, err := sink.Client.Post(sink.URL, "application/json", bytes.NewReader(body))
+	if err != nil {
+		return errors.Wrap(err, "failed to post events")
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		msg, _ := ioutil.ReadAll(io.LimitReader(resp.Body, 1024))
+		return fmt.Errorf("sink responded with %s: %s", resp.Status, strings.TrimSpace(string(msg)))
+	}
+
+	return nil
+}
@@ -0,0 +1,133 @@
+package export
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stellar/horizon/db2/history"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFileSink(t *testing.T) {
+	dir, err := ioutil.TempDir("", "export")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "events.jsonl")
+
+	sink, err := NewFileSink(path)
+	require.NoError(t, err)
+
+	last, err := sink.LastDelivered()
+	require.NoError(t, err)
+	assert.Equal(t, history.OutboxPosition{}, last)
+
+	err = sink.Deliver([]Event{
+		{ID: 1, TxID: 7, Type: "ledger_closed", Details: json.RawMessage(`{"sequence":2}`)},
+		{ID: 2, TxID: 7, Type: "transaction", Details: json.RawMessage(`{"hash":"abc"}`)},
+	})
+	require.NoError(t, err)
+
+	last, err = sink.LastDelivered()
+	require.NoError(t, err)
+	assert.Equal(t, history.OutboxPosition{TxID: 7, EventID: 2}, last)
+	require.NoError(t, sink.Close())
+
+	// simulate a delivery interrupted part way through a line
+	f, err := os.OpenFile(path, os.O_APPEND|os.O_WRONLY, 0644)
+	require.NoError(t, err)
+	_, err = f.WriteString(`{"id":3,"txid":7,"type":"oper`)
+	require.NoError(t, err)
+	require.NoError(t, f.Close())
+
+	sink, err = NewFileSink(path)
+	require.NoError(t, err)
+	defer sink.Close()
+
+	last, err = sink.LastDelivered()
+	require.NoError(t, err)
+	assert.Equal(t, history.OutboxPosition{TxID: 7, EventID: 2}, last)
+
+	err = sink.Deliver([]Event{{ID: 3, TxID: 7, Type: "operation", Details: json.RawMessage(`{}`)}})
+	require.NoError(t, err)
+
+	contents, err := ioutil.ReadFile(path)
+	require.NoError(t, err)
+
+	var ids []int64
+	for _, line := range splitLines(contents) {
+		var event Event
+		require.NoError(t, json.Unmarshal(line, &event))
+		ids = append(ids, event.ID)
+	}
+	assert.Equal(t, []int64{1, 2, 3}, ids)
+}
+
+func TestHTTPSink(t *testing.T) {
+	var received []Event
+	status := http.StatusOK
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "POST", r.Method)
+		assert.Equal(t, "application/json", r.Header.Get("Content-Type"))
+
+		var events []Event
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&events))
+		received = append(received, events...)
+		w.WriteHeader(status)
+	}))
+	defer server.Close()
+
+	sink, err := NewSink(server.URL)
+	require.NoError(t, err)
+	require.IsType(t, &HTTPSink{}, sink)
+
+	events := []Event{
+		{ID: 1, Type: "ledger_closed", Details: json.RawMessage(`{}`)},
+		{ID: 2, Type: "transaction", Details: json.RawMessage(`{}`)},
+	}
+
+	err = sink.Deliver(events)
+	require.NoError(t, err)
+	if assert.Len(t, received, 2) {
+		assert.Equal(t, int64(1), received[0].ID)
+		assert.Equal(t, int64(2), received[1].ID)
+	}
+
+	status = http.StatusServiceUnavailable
+	err = sink.Deliver(events)
+	assert.Error(t, err)
+}
+
+func TestNewSink(t *testing.T) {
+	dir, err := ioutil.TempDir("", "export")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	sink, err := NewSink("file://" + filepath.Join(dir, "a.jsonl"))
+	require.NoError(t, err)
+	assert.IsType(t, &FileSink{}, sink)
+
+	sink, err = NewSink(filepath.Join(dir, "b.jsonl"))
+	require.NoError(t, err)
+	assert.IsType(t, &FileSink{}, sink)
+
+	_, err = NewSink("ftp://example.com/events")
+	assert.Error(t, err)
+}
+
+func splitLines(contents []byte) (lines [][]byte) {
+	start := 0
+	for i, b := range contents {
+		if b == '\n' {
+			lines = append(lines, contents[start:i])
+			start = i + 1
+		}
+	}
+	return
+}
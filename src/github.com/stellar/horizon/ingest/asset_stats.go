@@ -0,0 +1,61 @@
+package ingest
+
+import (
+	"github.com/stellar/go/support/errors"
+	"github.com/stellar/horizon/db2/core"
+	"github.com/stellar/horizon/log"
+)
+
+// seedAssetStats fills an empty `asset_stats` table from the trustlines in
+// stellar-core.  Ingestion only refreshes the statistics of the assets changed
+// by the ledgers it ingests, so without seeding, assets whose trustlines have
+// not changed since the table was created would never be listed.
+func (i *System) seedAssetStats() error {
+	var seeded bool
+	err := i.HorizonDB.GetRaw(&seeded, "SELECT EXISTS (SELECT 1 FROM asset_stats)")
+	if err != nil {
+		return errors.Wrap(err, "failed to check asset stats")
+	}
+
+	if seeded {
+		return nil
+	}
+
+	var stats []core.AssetStatRow
+	cq := &core.Q{Session: i.CoreDB}
+	err = cq.AssetStats(&stats)
+	if err != nil {
+		return errors.Wrap(err, "failed to load core asset stats")
+	}
+
+	if len(stats) == 0 {
+		return nil
+	}
+
+	ingestion := &Ingestion{DB: i.HorizonDB.Clone(), Lock: i.LockMode}
+	err = ingestion.Start()
+	if err != nil {
+		return errors.Wrap(err, "failed to begin ingestion")
+	}
+	defer ingestion.Rollback()
+
+	for _, stat := range stats {
+		asset, err := core.AssetFromDB(stat.AssetType, stat.AssetCode, stat.Issuer)
+		if err != nil {
+			return errors.Wrap(err, "failed to build asset")
+		}
+
+		err = ingestion.AssetStat(asset, stat.AssetStat)
+		if err != nil {
+			return errors.Wrapf(err, "failed to seed stats of %s", asset.String())
+		}
+	}
+
+	err = ingestion.Close()
+	if err != nil {
+		return errors.Wrap(err, "failed to commit asset stats")
+	}
+
+	log.WithField("assets", len(stats)).Info("ingest: seeded asset stats from stellar-core")
+	return nil
+}
@@ -13,6 +13,12 @@ func (ei *EffectIngestion) Add(aid xdr.AccountId, typ history.EffectType, detail
 	}
 
 	ei.added++
+
+	if ei.Dest.derived != nil {
+		ei.err = ei.Dest.derived.effect(aid, ei.OperationID, ei.added, typ, details)
+		return ei.err == nil
+	}
+
 	var haid int64
 
 	haid, ei.err = ei.parent.getParticipantID(aid)
@@ -25,6 +31,11 @@ func (ei *EffectIngestion) Add(aid xdr.AccountId, typ history.EffectType, detail
 		return false
 	}
 
+	ei.err = ei.Dest.effectEvent(aid, ei.OperationID, ei.added, typ, details)
+	if ei.err != nil {
+		return false
+	}
+
 	return true
 }
 
@@ -0,0 +1,140 @@
+package ingest
+
+import (
+	"sync"
+
+	"github.com/stellar/go/support/db"
+	"github.com/stellar/go/support/errors"
+)
+
+// DefaultLeaderLockID is the key of the postgres advisory lock held by the
+// horizon instance that ingests into a history database.  It spells "horizon"
+// in ascii.
+const DefaultLeaderLockID = 0x686f72697a6f6e
+
+// IngestionLockID is the key of the postgres advisory lock taken by every
+// transaction that writes ingested data to a history database.  It spells
+// "ingest" in ascii.
+const IngestionLockID = 0x696e67657374
+
+// LockMode is the mode in which an Ingestion takes the ingestion lock.
+type LockMode int
+
+const (
+	// LockExclusive takes the lock exclusively, failing with
+	// ErrIngestionLocked when another transaction holds it.
+	LockExclusive LockMode = iota
+
+	// LockWait takes the lock exclusively, waiting for other transactions to
+	// release it.
+	LockWait
+
+	// LockShared takes the lock in shared mode, waiting for transactions that
+	// hold it exclusively.  It is used by the concurrent sessions of a parallel
+	// reingestion, which exclude other writers but not each other.
+	LockShared
+)
+
+// ErrIngestionLocked is returned when an ingestion cannot take the ingestion
+// lock because another process is writing to the history database.
+var ErrIngestionLocked = errors.New("another process is writing to the history database")
+
+// The roles reported by System.Role.
+const (
+	RoleLeader  = "leader"
+	RoleStandby = "standby"
+)
+
+// Election elects a single leader among the ingestion systems writing to the
+// same history database, using a postgres advisory lock.  The leader holds the
+// lock within an open transaction, so that it is released as soon as the
+// leader's connection to the database is closed, for example when the leader's
+// process dies.
+//
+// The election decides which system ticks, but does not guard the writes
+// themselves: a leader that lost its lock may still have a session in flight.
+// Those are guarded by the ingestion lock that each ingestion transaction
+// takes, so that a new leader's sessions fail until the old leader's session
+// has committed or rolled back.
+type Election struct {
+	// DB is the horizon database the lock is taken in.
+	DB *db.Session
+
+	// LockID is the key of the advisory lock.  Only systems using the same
+	// LockID compete with each other.
+	LockID int64
+
+	lock sync.Mutex
+	held *db.Session
+}
+
+// NewElection returns an election held in the database of `horizon`.
+func NewElection(horizon *db.Session) *Election {
+	return &Election{
+		DB:     horizon,
+		LockID: DefaultLeaderLockID,
+	}
+}
+
+// Campaign tries to make the caller the leader, returning whether it is.  A
+// leader that calls Campaign again checks that it still holds the lock.
+func (e *Election) Campaign() (bool, error) {
+	e.lock.Lock()
+	defer e.lock.Unlock()
+
+	if e.held != nil {
+		var alive int
+		err := e.held.GetRaw(&alive, "SELECT 1")
+		if err == nil {
+			return true, nil
+		}
+
+		e.held.Rollback()
+		e.held = nil
+		return false, errors.Wrap(err, "lost connection holding leader lock")
+	}
+
+	s := e.DB.Clone()
+	err := s.Begin()
+	if err != nil {
+		return false, errors.Wrap(err, "failed to begin transaction")
+	}
+
+	var acquired bool
+	err = s.GetRaw(&acquired, "SELECT pg_try_advisory_xact_lock(?)", e.LockID)
+	if err != nil {
+		s.Rollback()
+		return false, errors.Wrap(err, "failed to try leader lock")
+	}
+
+	if !acquired {
+		s.Rollback()
+		return false, nil
+	}
+
+	e.held = s
+	return true, nil
+}
+
+// IsLeader returns whether the last call to Campaign made the caller the
+// leader.
+func (e *Election) IsLeader() bool {
+	e.lock.Lock()
+	defer e.lock.Unlock()
+	return e.held != nil
+}
+
+// Resign releases the lock, if held, allowing another system to become the
+// leader.
+func (e *Election) Resign() error {
+	e.lock.Lock()
+	defer e.lock.Unlock()
+
+	if e.held == nil {
+		return nil
+	}
+
+	err := e.held.Rollback()
+	e.held = nil
+	return err
+}
@@ -0,0 +1,131 @@
+package ingest
+
+import (
+	"testing"
+
+	"github.com/stellar/horizon/test"
+)
+
+func TestElection(t *testing.T) {
+	tt := test.Start(t)
+	defer tt.Finish()
+
+	first := NewElection(tt.HorizonSession())
+	second := NewElection(tt.HorizonSession())
+	defer first.Resign()
+	defer second.Resign()
+
+	leader, err := first.Campaign()
+	tt.Require.NoError(err)
+	tt.Assert.True(leader)
+	tt.Assert.True(first.IsLeader())
+
+	leader, err = second.Campaign()
+	tt.Require.NoError(err)
+	tt.Assert.False(leader)
+	tt.Assert.False(second.IsLeader())
+
+	// the leader remains the leader
+	leader, err = first.Campaign()
+	tt.Require.NoError(err)
+	tt.Assert.True(leader)
+
+	// once the leader resigns, the standby takes over
+	tt.Require.NoError(first.Resign())
+	tt.Assert.False(first.IsLeader())
+
+	leader, err = second.Campaign()
+	tt.Require.NoError(err)
+	tt.Assert.True(leader)
+
+	leader, err = first.Campaign()
+	tt.Require.NoError(err)
+	tt.Assert.False(leader)
+}
+
+func TestTick_Standby(t *testing.T) {
+	tt := test.Start(t).ScenarioWithoutHorizon("kahuna")
+	defer tt.Finish()
+
+	other := NewElection(tt.HorizonSession())
+	defer other.Resign()
+
+	leader, err := other.Campaign()
+	tt.Require.NoError(err)
+	tt.Require.True(leader)
+
+	s := sys(tt)
+	s.Election = NewElection(tt.HorizonSession())
+	defer s.Election.Resign()
+
+	// while another system leads, ticking ingests nothing
+	tt.Assert.Nil(s.Tick())
+	tt.Assert.Equal(RoleStandby, s.Role())
+	tt.Assert.Equal(int64(0), s.Metrics.Leader.Value())
+
+	// once the leader stops, the standby takes over
+	tt.Require.NoError(other.Resign())
+
+	is := s.Tick()
+	tt.Require.NotNil(is)
+	tt.Require.NoError(is.Err)
+	tt.Assert.Equal(57, is.Ingested)
+	tt.Assert.Equal(RoleLeader, s.Role())
+	tt.Assert.Equal(int64(1), s.Metrics.Leader.Value())
+}
+
+func TestIngestionLock(t *testing.T) {
+	tt := test.Start(t)
+	defer tt.Finish()
+
+	ingestion := func(lock LockMode) *Ingestion {
+		return &Ingestion{DB: tt.HorizonSession().Clone(), Lock: lock}
+	}
+
+	// only one exclusive ingestion writes at a time
+	first := ingestion(LockExclusive)
+	tt.Require.NoError(first.Start())
+
+	second := ingestion(LockExclusive)
+	tt.Assert.Equal(ErrIngestionLocked, second.Start())
+
+	tt.Require.NoError(first.Rollback())
+	tt.Require.NoError(second.Start())
+	tt.Require.NoError(second.Rollback())
+
+	// shared ingestions write together, but exclude exclusive ones
+	a := ingestion(LockShared)
+	b := ingestion(LockShared)
+	tt.Require.NoError(a.Start())
+	tt.Require.NoError(b.Start())
+
+	tt.Assert.Equal(ErrIngestionLocked, ingestion(LockExclusive).Start())
+
+	tt.Require.NoError(a.Rollback())
+	tt.Require.NoError(b.Rollback())
+}
+
+func TestTick_LockedByAnotherProcess(t *testing.T) {
+	tt := test.Start(t).ScenarioWithoutHorizon("kahuna")
+	defer tt.Finish()
+
+	// a session of a leader that lost its election is still writing
+	writer := &Ingestion{DB: tt.HorizonSession().Clone()}
+	tt.Require.NoError(writer.Start())
+
+	s := sys(tt)
+	s.Election = NewElection(tt.HorizonSession())
+	defer s.Election.Resign()
+
+	is := s.Tick()
+	tt.Require.NotNil(is)
+	tt.Assert.Equal(ErrIngestionLocked, is.Err)
+	tt.Assert.Equal(0, is.Ingested)
+
+	tt.Require.NoError(writer.Rollback())
+
+	is = s.Tick()
+	tt.Require.NotNil(is)
+	tt.Require.NoError(is.Err)
+	tt.Assert.Equal(57, is.Ingested)
+}
@@ -16,6 +16,52 @@ import (
 	"github.com/stellar/horizon/db2/sqx"
 )
 
+// AssetStat updates the row of the `asset_stats` table for `asset` to reflect
+// `stat`, creating it if needed.  Assets that are no longer held by any
+// account are removed from the table.  The row is upserted in a single
+// statement, as the concurrent sessions of a parallel reingestion can create
+// the same asset's row.
+func (ingest *Ingestion) AssetStat(asset xdr.Asset, stat core.AssetStat) error {
+	var typ, code, issuer string
+	err := asset.Extract(&typ, &code, &issuer)
+	if err != nil {
+		return errors.Wrap(err, "failed to extract asset")
+	}
+
+	if stat.NumAccounts == 0 {
+		_, err = ingest.DB.Exec(sq.Delete("asset_stats").Where(sq.Eq{
+			"asset_type":   typ,
+			"asset_code":   code,
+			"asset_issuer": issuer,
+		}))
+		return err
+	}
+
+	sql := ingest.assetStats.Values(
+		typ,
+		code,
+		issuer,
+		stat.Amount,
+		stat.NumAccounts,
+		stat.Flags,
+	).Suffix(`ON CONFLICT (asset_type, asset_code, asset_issuer) DO UPDATE SET
+		amount = excluded.amount,
+		num_accounts = excluded.num_accounts,
+		flags = excluded.flags`)
+
+	_, err = ingest.DB.Exec(sql)
+	return err
+}
+
+// AssetStatFlags updates the flags recorded in the `asset_stats` table for
+// every asset issued by `issuer`.
+func (ingest *Ingestion) AssetStatFlags(issuer string, flags xdr.AccountFlags) error {
+	_, err := ingest.DB.Exec(sq.Update("asset_stats").
+		Set("flags", flags).
+		Where("asset_issuer = ?", issuer))
+	return err
+}
+
 // ClearAll clears the entire history database
 func (ingest *Ingestion) ClearAll() error {
 	return ingest.Clear(0, math.MaxInt64)
@@ -26,6 +72,17 @@ func (ingest *Ingestion) ClearAll() error {
 func (ingest *Ingestion) Clear(start int64, end int64) error {
 	clear := ingest.DB.DeleteRange
 
+	// processor tables are cleared first, as they may reference the built-in
+	// tables.
+	for _, p := range ingest.Processors {
+		for _, table := range p.Tables() {
+			err := clear(start, end, table.Name, table.IDColumn)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
 	err := clear(start, end, "history_effects", "history_operation_id")
 	if err != nil {
 		return err
@@ -124,7 +181,7 @@ func (ingest *Ingestion) Ledger(
 		return err
 	}
 
-	return nil
+	return ingest.ledgerEvent(id, header, txs, ops)
 }
 
 // Operation ingests the provided operation data into a new row in the
@@ -149,7 +206,7 @@ func (ingest *Ingestion) Operation(
 		return err
 	}
 
-	return nil
+	return ingest.operationEvent(id, txid, order, source, typ, details)
 }
 
 // OperationParticipants ingests the provided accounts `aids` as participants of
@@ -179,18 +236,51 @@ func (ingest *Ingestion) Rollback() (err error) {
 	return
 }
 
-// Start makes the ingestion reeady, initializing the insert builders and tx
+// Start makes the ingestion reeady, initializing the insert builders and tx.
+// The transaction takes the ingestion lock in the ingestion's LockMode, so that
+// only one process writes to the history database at a time.
 func (ingest *Ingestion) Start() (err error) {
 	err = ingest.DB.Begin()
 	if err != nil {
 		return
 	}
 
+	err = ingest.lock()
+	if err != nil {
+		ingest.DB.Rollback()
+		return
+	}
+
 	ingest.createInsertBuilders()
 
 	return
 }
 
+// lock takes the ingestion lock for the current transaction.  It is released
+// when the transaction commits or rolls back.
+func (ingest *Ingestion) lock() error {
+	switch ingest.Lock {
+	case LockWait:
+		_, err := ingest.DB.ExecRaw("SELECT pg_advisory_xact_lock(?)", IngestionLockID)
+		return err
+	case LockShared:
+		_, err := ingest.DB.ExecRaw("SELECT pg_advisory_xact_lock_shared(?)", IngestionLockID)
+		return err
+	}
+
+	var acquired bool
+	err := ingest.DB.GetRaw(&acquired, "SELECT pg_try_advisory_xact_lock(?)", IngestionLockID)
+	if err != nil {
+		return err
+	}
+
+	if !acquired {
+		return ErrIngestionLocked
+	}
+
+	return nil
+}
+
 // transactionInsertBuilder returns sql.InsertBuilder for a single transaction
 func (ingest *Ingestion) transactionInsertBuilder(id int64, tx *core.Transaction, fee *core.TransactionFee) sq.InsertBuilder {
 	// Enquote empty signatures
@@ -215,6 +305,7 @@ func (ingest *Ingestion) transactionInsertBuilder(id int64, tx *core.Transaction
 		tx.Memo(),
 		time.Now().UTC(),
 		time.Now().UTC(),
+		tx.IsSuccessful(),
 	)
 }
 
@@ -225,6 +316,9 @@ func (ingest *Ingestion) Trade(
 	buyer xdr.AccountId,
 	trade xdr.ClaimOfferAtom,
 ) error {
+	if ingest.derived != nil {
+		return ingest.derived.trade(opid, order, buyer, trade)
+	}
 
 	var (
 		soldType     string
@@ -275,7 +369,7 @@ func (ingest *Ingestion) Trade(
 		return errors.Wrap(err, "failed to exec sql")
 	}
 
-	return nil
+	return ingest.tradeEvent(opid, order, buyer, trade)
 }
 
 // Transaction ingests the provided transaction data into a new row in the
@@ -292,7 +386,7 @@ func (ingest *Ingestion) Transaction(
 		return err
 	}
 
-	return nil
+	return ingest.transactionEvent(id, tx)
 }
 
 // TransactionParticipants ingests the provided account ids as participants of
@@ -337,6 +431,15 @@ func (ingest *Ingestion) createInsertBuilders() {
 		"protocol_version",
 	)
 
+	ingest.assetStats = sq.Insert("asset_stats").Columns(
+		"asset_type",
+		"asset_code",
+		"asset_issuer",
+		"amount",
+		"num_accounts",
+		"flags",
+	)
+
 	ingest.accounts = sq.Insert("history_accounts").Columns(
 		"address",
 	)
@@ -360,6 +463,7 @@ func (ingest *Ingestion) createInsertBuilders() {
 		"memo",
 		"created_at",
 		"updated_at",
+		"successful",
 	)
 
 	ingest.transaction_participants = sq.Insert("history_transaction_participants").Columns(
@@ -389,6 +493,15 @@ func (ingest *Ingestion) createInsertBuilders() {
 		"details",
 	)
 
+	ingest.outbox = sq.Insert("outbox_events").Columns(
+		"type",
+		"history_id",
+		"ledger_sequence",
+		"details",
+		"created_at",
+		"reingested",
+	)
+
 	ingest.trades = sq.Insert("history_trades").Columns(
 		"history_operation_id",
 		"\"order\"",
@@ -415,6 +528,11 @@ func (ingest *Ingestion) commit() error {
 	return nil
 }
 
+// getParticipantID returns the id of the `history_accounts` row for `aid`.
+// Missing rows are created outside of the ingestion's transaction, so that the
+// concurrent sessions of a parallel reingestion do not conflict, or deadlock,
+// on the unique index of addresses while their transactions are open.  A row
+// created for an ingestion that is rolled back is left in place, to be reused.
 func (ingest *Ingestion) getParticipantID(
 	aid xdr.AccountId,
 ) (result int64, err error) {
@@ -432,15 +550,20 @@ func (ingest *Ingestion) getParticipantID(
 		result = existing.ID
 		return
 	}
-	err = ingest.DB.GetRaw(
-		&result,
-		`INSERT INTO history_accounts (address) VALUES (?) RETURNING id`,
-		aid.Address(),
-	)
+
+	_, err = ingest.DB.Clone().Exec(ingest.accounts.
+		Values(aid.Address()).
+		Suffix("ON CONFLICT (address) DO NOTHING"))
+	if err != nil {
+		return
+	}
+
+	err = q.AccountByAddress(&existing, aid.Address())
 	if err != nil {
 		return
 	}
 
+	result = existing.ID
 	return
 }
 
@@ -9,6 +9,8 @@ import (
 	sq "github.com/Masterminds/squirrel"
 	metrics "github.com/rcrowley/go-metrics"
 	"github.com/stellar/go/support/db"
+	"github.com/stellar/go/xdr"
+	"github.com/stellar/horizon/bus"
 	"github.com/stellar/horizon/db2/core"
 )
 
@@ -21,7 +23,12 @@ const (
 	// Scripts, that have yet to be ported to this codebase can then be leveraged
 	// to re-ingest old data with the new algorithm, providing a seamless
 	// transition when the ingested data's structure changes.
-	CurrentVersion = 9
+	CurrentVersion = 11
+
+	// DefaultRepairWindow is the default number of ledgers, counting back from
+	// the latest ledger in the history database, that are searched for a
+	// ledger shared with stellar-core when the ledger chain is broken.
+	DefaultRepairWindow = 1000
 )
 
 // Cursor iterates through a stellar core database's ledgers
@@ -89,8 +96,34 @@ type System struct {
 	// stellar-core
 	SkipCursorUpdate bool
 
-	lock    sync.Mutex
-	current *Session
+	// Processors derive additional data from each ingested ledger.  New
+	// populates it with the processors registered using RegisterProcessor.
+	Processors []Processor
+
+	// Outbox causes the ingested data to also be written to the
+	// `outbox_events` table, for delivery by the export package.
+	Outbox bool
+
+	// RepairWindow bounds the number of ledgers the system reingests to repair
+	// the history database when its latest ledger is not the parent of the
+	// next ledger in stellar-core.  When zero, the chain is not repaired and
+	// ingestion halts until the history database is corrected by hand.
+	RepairWindow int32
+
+	// LockMode is the mode in which the system's ingestions take the ingestion
+	// lock.  The ingestion server uses LockExclusive, so that a tick fails
+	// rather than waits while another process writes, while the db commands
+	// use LockWait.
+	LockMode LockMode
+
+	// Election, when set, restricts ingestion to the single system elected
+	// leader among those sharing the history database.  The others stand by,
+	// campaigning on each tick to take over should the leader stop.
+	Election *Election
+
+	lock      sync.Mutex
+	current   *Session
+	repairErr error
 }
 
 // IngesterMetrics tracks all the metrics for the ingestion subsystem
@@ -98,6 +131,19 @@ type IngesterMetrics struct {
 	ClearLedgerTimer  metrics.Timer
 	IngestLedgerTimer metrics.Timer
 	LoadLedgerTimer   metrics.Timer
+
+	// LedgerChainRepairs and LedgerChainRepairFailures count the attempts to
+	// repair a broken ledger chain that succeeded and failed.
+	LedgerChainRepairs        metrics.Meter
+	LedgerChainRepairFailures metrics.Meter
+
+	// LedgerChain is unhealthy while the system cannot repair a broken ledger
+	// chain on its own.
+	LedgerChain metrics.Healthcheck
+
+	// Leader is 1 while the system is the elected leader and ingests, and 0
+	// while it stands by.
+	Leader metrics.Gauge
 }
 
 // Ingestion receives write requests from a Session
@@ -106,6 +152,26 @@ type Ingestion struct {
 	// database.
 	DB *db.Session
 
+	// Processors are the processors whose tables are cleared along with the
+	// built-in tables, and which a Session calls as it ingests each ledger.
+	Processors []Processor
+
+	// Outbox causes an event to be written to the `outbox_events` table for
+	// each ledger, transaction, operation, effect and trade ingested.
+	Outbox bool
+
+	// Reingest marks the outbox events written by the ingestion as
+	// reingested, as the events of ledgers that were ingested before.
+	Reingest bool
+
+	// Lock is the mode in which each of the ingestion's transactions takes the
+	// ingestion lock.
+	Lock LockMode
+
+	// derived, when set, collects the effects and trades of the ingestion in
+	// place of writing them.  It is used to verify history.
+	derived *derivation
+
 	ledgers                  sq.InsertBuilder
 	transactions             sq.InsertBuilder
 	transaction_participants sq.InsertBuilder
@@ -114,6 +180,8 @@ type Ingestion struct {
 	effects                  sq.InsertBuilder
 	accounts                 sq.InsertBuilder
 	trades                   sq.InsertBuilder
+	outbox                   sq.InsertBuilder
+	assetStats               sq.InsertBuilder
 }
 
 // Session represents a single attempt at ingesting data into the history
@@ -150,6 +218,18 @@ type Session struct {
 	// Ingested is the number of ledgers that were successfully ingested during
 	// this session.
 	Ingested int
+
+	// event accumulates the data ingested for the current ledger.  Once the
+	// ledger has been ingested it is queued on events, which are published to
+	// the bus after the session's transaction has been committed.
+	event    bus.Event
+	events   []bus.Event
+	accounts map[string]bool
+
+	// assetStats and assetIssuers track the assets and issuers whose
+	// statistics were changed by the ledger currently being ingested.
+	assetStats   map[string]xdr.Asset
+	assetIssuers map[string]bool
 }
 
 // New initializes the ingester, causing it to begin polling the stellar-core
@@ -160,11 +240,17 @@ func New(network string, coreURL string, core, horizon *db.Session) *System {
 		StellarCoreURL: coreURL,
 		HorizonDB:      horizon,
 		CoreDB:         core,
+		Processors:     Processors(),
+		RepairWindow:   DefaultRepairWindow,
 	}
 
 	i.Metrics.ClearLedgerTimer = metrics.NewTimer()
 	i.Metrics.IngestLedgerTimer = metrics.NewTimer()
 	i.Metrics.LoadLedgerTimer = metrics.NewTimer()
+	i.Metrics.LedgerChainRepairs = metrics.NewMeter()
+	i.Metrics.LedgerChainRepairFailures = metrics.NewMeter()
+	i.Metrics.LedgerChain = metrics.NewHealthcheck(i.checkLedgerChain)
+	i.Metrics.Leader = metrics.NewGauge()
 	return i
 }
 
@@ -175,7 +261,10 @@ func NewSession(first, last int32, i *System) *Session {
 
 	return &Session{
 		Ingestion: &Ingestion{
-			DB: hdb,
+			DB:         hdb,
+			Processors: i.Processors,
+			Outbox:     i.Outbox,
+			Lock:       i.LockMode,
 		},
 		Cursor: &Cursor{
 			FirstLedger: first,
@@ -4,6 +4,10 @@ import (
 	"testing"
 
 	"github.com/stellar/go/network"
+	"github.com/stellar/go/xdr"
+	"github.com/stellar/horizon/bus"
+	"github.com/stellar/horizon/db2"
+	"github.com/stellar/horizon/db2/history"
 	"github.com/stellar/horizon/test"
 )
 
@@ -27,6 +31,172 @@ func TestIngest(t *testing.T) {
 	tt.Require.NoError(s.Err, "Couldn't re-import, even with clear allowed")
 }
 
+func TestOfferEffects(t *testing.T) {
+	tt := test.Start(t).ScenarioWithoutHorizon("trades")
+	defer tt.Finish()
+
+	s := ingest(tt)
+	tt.Require.NoError(s.Err)
+
+	q := &history.Q{Session: tt.HorizonSession()}
+	count := func(typ history.EffectType) int {
+		var effects []history.Effect
+		err := q.Effects().OfType(typ).Select(&effects)
+		tt.Require.NoError(err)
+		return len(effects)
+	}
+
+	// bartek's three offers and scott's offer to sell USD for native are
+	// created. scott's offer to sell USD for EUR is filled immediately.
+	tt.Assert.Equal(4, count(history.EffectOfferCreated))
+
+	// bartek's best priced offer is partially filled by scott
+	tt.Assert.Equal(1, count(history.EffectOfferUpdated))
+	tt.Assert.Equal(0, count(history.EffectOfferRemoved))
+
+	var effects []history.Effect
+	err := q.Effects().OfType(history.EffectOfferUpdated).Select(&effects)
+	tt.Require.NoError(err)
+
+	var details struct {
+		OfferID int64  `json:"offer_id"`
+		Amount  string `json:"amount"`
+	}
+	tt.Require.NoError(effects[0].UnmarshalDetails(&details))
+	tt.Assert.Equal(int64(1), details.OfferID)
+	tt.Assert.Equal("50.0000000", details.Amount)
+}
+
+func TestAssetStats(t *testing.T) {
+	tt := test.Start(t).ScenarioWithoutHorizon("trades")
+	defer tt.Finish()
+
+	s := ingest(tt)
+	tt.Require.NoError(s.Err)
+
+	q := &history.Q{Session: tt.HorizonSession()}
+	var stats []history.AssetStat
+	err := q.AssetStats().Page(db2.MustPageQuery("", "asc", 10)).Select(&stats)
+	tt.Require.NoError(err)
+
+	// both bartek and scott hold EUR and USD
+	if tt.Assert.Len(stats, 2) {
+		for _, stat := range stats {
+			tt.Assert.Equal("credit_alphanum4", stat.AssetType)
+			tt.Assert.Equal(int32(2), stat.NumAccounts)
+			tt.Assert.Equal(xdr.Int64(5000000000), stat.Amount)
+		}
+	}
+
+	// re-ingesting leaves the stats unchanged
+	s.Err = nil
+	s.ClearExisting = true
+	s.Run()
+	tt.Require.NoError(s.Err)
+
+	var again []history.AssetStat
+	err = q.AssetStats().Page(db2.MustPageQuery("", "asc", 10)).Select(&again)
+	tt.Require.NoError(err)
+	tt.Assert.Equal(stats, again)
+}
+
+func TestSeedAssetStats(t *testing.T) {
+	tt := test.Start(t).Scenario("trades")
+	defer tt.Finish()
+
+	hdb := tt.HorizonSession()
+	_, err := hdb.ExecRaw("DELETE FROM asset_stats")
+	tt.Require.NoError(err)
+
+	s := sys(tt)
+	tt.Require.NoError(s.seedAssetStats())
+
+	q := &history.Q{Session: hdb}
+	var stats []history.AssetStat
+	err = q.AssetStats().Page(db2.MustPageQuery("", "asc", 10)).Select(&stats)
+	tt.Require.NoError(err)
+
+	if tt.Assert.Len(stats, 2) {
+		for _, stat := range stats {
+			tt.Assert.Equal(int32(2), stat.NumAccounts)
+			tt.Assert.Equal(xdr.Int64(5000000000), stat.Amount)
+		}
+	}
+
+	// a table that has rows is left to ingestion
+	_, err = hdb.ExecRaw("UPDATE asset_stats SET num_accounts = 1")
+	tt.Require.NoError(err)
+	tt.Require.NoError(s.seedAssetStats())
+
+	var count int
+	err = hdb.GetRaw(&count, "SELECT COUNT(*) FROM asset_stats WHERE num_accounts = 1")
+	tt.Require.NoError(err)
+	tt.Assert.Equal(2, count)
+
+	// a table emptied while the server is running is seeded again
+	_, err = hdb.ExecRaw("DELETE FROM asset_stats")
+	tt.Require.NoError(err)
+	tt.Require.NoError(s.Tick().Err)
+
+	err = hdb.GetRaw(&count, "SELECT COUNT(*) FROM asset_stats WHERE num_accounts = 2")
+	tt.Require.NoError(err)
+	tt.Assert.Equal(2, count)
+}
+
+func TestPublish(t *testing.T) {
+	tt := test.Start(t).ScenarioWithoutHorizon("base")
+	defer tt.Finish()
+
+	master := "GBRPYHIL2CI3FNQ4BXLFMNDLFJUNPU2HY3ZMFSHONUCEOASW7QC7OX2H"
+	ledgers := bus.Subscribe(bus.TopicLedgers)
+	defer ledgers.Close()
+	account := bus.Subscribe(bus.AccountTopic(master))
+	defer account.Close()
+
+	s := ingest(tt)
+	tt.Require.NoError(s.Err)
+
+	events, complete := ledgers.Drain()
+	tt.Assert.True(complete)
+	if tt.Assert.Len(events, s.Ingested) {
+		for i, e := range events {
+			tt.Assert.Equal(s.Cursor.FirstLedger+int32(i), e.Ledger.Sequence)
+		}
+	}
+
+	// events are published once committed, so the ledgers they announce can be
+	// loaded through other connections
+	q := &history.Q{Session: tt.HorizonSession()}
+	for _, e := range events {
+		var found history.Ledger
+		tt.Assert.NoError(q.LedgerBySequence(&found, e.Ledger.Sequence))
+	}
+
+	// the master account funds the other accounts in the scenario
+	events, _ = account.Drain()
+	tt.Assert.NotEmpty(events)
+	for _, e := range events {
+		tt.Assert.Contains(e.Accounts, master)
+	}
+
+	// a failed session publishes nothing
+	s.Err = nil
+	s.Run()
+	tt.Require.Error(s.Err)
+
+	events, _ = ledgers.Drain()
+	tt.Assert.Empty(events)
+
+	// reingested ledgers are not published again
+	s.Err = nil
+	s.ClearExisting = true
+	s.Run()
+	tt.Require.NoError(s.Err)
+
+	events, _ = ledgers.Drain()
+	tt.Assert.Empty(events)
+}
+
 func TestTick(t *testing.T) {
 	tt := test.Start(t).ScenarioWithoutHorizon("base")
 	defer tt.Finish()
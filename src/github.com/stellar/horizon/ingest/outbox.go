@@ -0,0 +1,164 @@
+package ingest
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/stellar/go/amount"
+	"github.com/stellar/go/xdr"
+	"github.com/stellar/horizon/db2/core"
+	"github.com/stellar/horizon/db2/history"
+	"github.com/stellar/horizon/resource/effects"
+	"github.com/stellar/horizon/resource/operations"
+	"github.com/stellar/horizon/toid"
+)
+
+// The types of the events written to the `outbox_events` table.
+const (
+	OutboxLedgerClosed = "ledger_closed"
+	OutboxTransaction  = "transaction"
+	OutboxOperation    = "operation"
+	OutboxEffect       = "effect"
+	OutboxTrade        = "trade"
+)
+
+// outboxEvent adds a new row to the `outbox_events` table describing the
+// ledger, transaction or operation identified by `id`, when the ingestion
+// writes to the outbox.
+//
+// Clearing history leaves the outbox alone, so that no event is lost before
+// it is exported: the reaper deletes events once every export has delivered
+// them.  Reingesting a ledger therefore writes its events again, marked as
+// reingested.
+func (ingest *Ingestion) outboxEvent(typ string, id int64, details interface{}) error {
+	if !ingest.Outbox {
+		return nil
+	}
+
+	djson, err := json.Marshal(details)
+	if err != nil {
+		return err
+	}
+
+	sql := ingest.outbox.Values(
+		typ,
+		id,
+		toid.Parse(id).LedgerSequence,
+		djson,
+		time.Now().UTC(),
+		ingest.Reingest,
+	)
+
+	_, err = ingest.DB.Exec(sql)
+	return err
+}
+
+func (ingest *Ingestion) ledgerEvent(id int64, header *core.LedgerHeader, txs int, ops int) error {
+	return ingest.outboxEvent(OutboxLedgerClosed, id, map[string]interface{}{
+		"sequence":                header.Sequence,
+		"hash":                    header.LedgerHash,
+		"prev_hash":               header.PrevHash,
+		"closed_at":               time.Unix(header.CloseTime, 0).UTC(),
+		"transaction_count":       txs,
+		"operation_count":         ops,
+		"total_coins":             amount.String(header.Data.TotalCoins),
+		"fee_pool":                amount.String(header.Data.FeePool),
+		"base_fee_in_stroops":     header.Data.BaseFee,
+		"base_reserve_in_stroops": header.Data.BaseReserve,
+		"max_tx_set_size":         header.Data.MaxTxSetSize,
+		"protocol_version":        header.Data.LedgerVersion,
+	})
+}
+
+func (ingest *Ingestion) transactionEvent(id int64, tx *core.Transaction) error {
+	return ingest.outboxEvent(OutboxTransaction, id, map[string]interface{}{
+		"id":                      id,
+		"hash":                    tx.TransactionHash,
+		"ledger":                  tx.LedgerSequence,
+		"application_order":       tx.Index,
+		"source_account":          tx.SourceAddress(),
+		"source_account_sequence": tx.Sequence(),
+		"fee_paid":                tx.Fee(),
+		"operation_count":         len(tx.Envelope.Tx.Operations),
+		"memo_type":               tx.MemoType(),
+		"memo":                    tx.Memo(),
+		"successful":              tx.IsSuccessful(),
+	})
+}
+
+func (ingest *Ingestion) operationEvent(
+	id int64,
+	txid int64,
+	order int32,
+	source xdr.AccountId,
+	typ xdr.OperationType,
+	details map[string]interface{},
+) error {
+	return ingest.outboxEvent(OutboxOperation, id, map[string]interface{}{
+		"id":                id,
+		"transaction_id":    txid,
+		"application_order": order,
+		"source_account":    source.Address(),
+		"type":              operations.TypeNames[typ],
+		"type_i":            typ,
+		"details":           details,
+	})
+}
+
+func (ingest *Ingestion) effectEvent(
+	account xdr.AccountId,
+	opid int64,
+	order int,
+	typ history.EffectType,
+	details interface{},
+) error {
+	return ingest.outboxEvent(OutboxEffect, opid, map[string]interface{}{
+		"operation_id": opid,
+		"order":        order,
+		"account":      account.Address(),
+		"type":         effects.TypeNames[typ],
+		"type_i":       typ,
+		"details":      details,
+	})
+}
+
+func (ingest *Ingestion) tradeEvent(
+	opid int64,
+	order int32,
+	buyer xdr.AccountId,
+	trade xdr.ClaimOfferAtom,
+) error {
+	details := map[string]interface{}{
+		"operation_id":  opid,
+		"order":         order,
+		"offer_id":      trade.OfferId,
+		"seller":        trade.SellerId.Address(),
+		"buyer":         buyer.Address(),
+		"sold_amount":   amount.String(trade.AmountSold),
+		"bought_amount": amount.String(trade.AmountBought),
+	}
+
+	assets := []struct {
+		prefix string
+		asset  xdr.Asset
+	}{
+		{"sold_", trade.AssetSold},
+		{"bought_", trade.AssetBought},
+	}
+
+	for _, a := range assets {
+		var typ, code, issuer string
+		err := a.asset.Extract(&typ, &code, &issuer)
+		if err != nil {
+			return err
+		}
+
+		details[a.prefix+"asset_type"] = typ
+		if typ != "native" {
+			details[a.prefix+"asset_code"] = code
+			details[a.prefix+"asset_issuer"] = issuer
+		}
+	}
+
+	return ingest.outboxEvent(OutboxTrade, opid, details)
+}
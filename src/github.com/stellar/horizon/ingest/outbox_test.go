@@ -0,0 +1,60 @@
+package ingest
+
+import (
+	"testing"
+
+	"github.com/stellar/horizon/test"
+)
+
+func TestOutbox(t *testing.T) {
+	tt := test.Start(t).ScenarioWithoutHorizon("kahuna")
+	defer tt.Finish()
+
+	hdb := tt.HorizonSession()
+	count := func(query string, args ...interface{}) (found int) {
+		err := hdb.GetRaw(&found, query, args...)
+		tt.Require.NoError(err)
+		return
+	}
+	events := func(typ string) int {
+		return count("SELECT COUNT(*) FROM outbox_events WHERE type = ?", typ)
+	}
+
+	// the outbox is not written by default
+	s := sys(tt)
+	tt.Require.NoError(s.Tick().Err)
+	tt.Assert.Equal(0, count("SELECT COUNT(*) FROM outbox_events"))
+
+	tt.Require.NoError(s.ClearAll())
+
+	s.Outbox = true
+	tt.Require.NoError(s.Tick().Err)
+
+	tt.Assert.Equal(count("SELECT COUNT(*) FROM history_ledgers"), events(OutboxLedgerClosed))
+	tt.Assert.Equal(count("SELECT COUNT(*) FROM history_transactions"), events(OutboxTransaction))
+	tt.Assert.Equal(count("SELECT COUNT(*) FROM history_operations"), events(OutboxOperation))
+	tt.Assert.Equal(count("SELECT COUNT(*) FROM history_effects"), events(OutboxEffect))
+	tt.Assert.Equal(count("SELECT COUNT(*) FROM history_trades"), events(OutboxTrade))
+	tt.Assert.NotEqual(0, events(OutboxTrade))
+
+	// events are written in the order they were ingested
+	tt.Assert.Equal(0, count(`
+		SELECT COUNT(*) FROM outbox_events a
+		JOIN outbox_events b ON a.id < b.id AND a.ledger_sequence > b.ledger_sequence
+	`))
+
+	// reingesting a ledger leaves its events in place and writes them again,
+	// marked as reingested
+	written := count("SELECT COUNT(*) FROM outbox_events")
+	ledger := count("SELECT COUNT(*) FROM outbox_events WHERE ledger_sequence = 3")
+	tt.Require.NotEqual(0, ledger)
+
+	tt.Require.NoError(s.ReingestSingle(3))
+	tt.Assert.Equal(written+ledger, count("SELECT COUNT(*) FROM outbox_events"))
+	tt.Assert.Equal(ledger, count("SELECT COUNT(*) FROM outbox_events WHERE reingested"))
+	tt.Assert.Equal(0, count("SELECT COUNT(*) FROM outbox_events WHERE reingested AND ledger_sequence <> 3"))
+
+	// clearing history leaves the outbox alone, too
+	tt.Require.NoError(s.ClearAll())
+	tt.Assert.Equal(written+ledger, count("SELECT COUNT(*) FROM outbox_events"))
+}
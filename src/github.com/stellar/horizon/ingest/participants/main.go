@@ -62,13 +62,13 @@ func ForTransaction(
 
 	result = append(result, tx.SourceAccount)
 
-	p, err := forMeta(meta)
+	p, err := forMeta(meta, false)
 	if err != nil {
 		return
 	}
 	result = append(result, p...)
 
-	p, err = forChanges(feeMeta)
+	p, err = forChanges(feeMeta, false)
 	if err != nil {
 		return
 	}
@@ -86,6 +86,20 @@ func ForTransaction(
 	return
 }
 
+// ForEntryOwners returns the accounts owning any of the ledger entries changed
+// by the provided transaction meta.  Unlike ForTransaction, it includes the
+// owners of trustlines, offers and data entries, such as the maker of an offer
+// that the transaction filled, who is not otherwise a participant.
+func ForEntryOwners(meta *xdr.TransactionMeta) (result []xdr.AccountId, err error) {
+	result, err = forMeta(meta, true)
+	if err != nil {
+		return
+	}
+
+	result = dedupe(result)
+	return
+}
+
 // dedupe remove any duplicate ids from `in`
 func dedupe(in []xdr.AccountId) (out []xdr.AccountId) {
 	set := map[string]xdr.AccountId{}
@@ -99,8 +113,11 @@ func dedupe(in []xdr.AccountId) (out []xdr.AccountId) {
 	return
 }
 
+// forChanges returns the accounts changed by `changes`, including the owners
+// of the other entries changed if `owners` is true.
 func forChanges(
 	changes *xdr.LedgerEntryChanges,
+	owners bool,
 ) (result []xdr.AccountId, err error) {
 
 	for _, c := range *changes {
@@ -108,13 +125,13 @@ func forChanges(
 
 		switch c.Type {
 		case xdr.LedgerEntryChangeTypeLedgerEntryCreated:
-			account = forLedgerEntry(c.MustCreated())
+			account = forLedgerEntry(c.MustCreated(), owners)
 		case xdr.LedgerEntryChangeTypeLedgerEntryRemoved:
-			account = forLedgerKey(c.MustRemoved())
+			account = forLedgerKey(c.MustRemoved(), owners)
 		case xdr.LedgerEntryChangeTypeLedgerEntryUpdated:
-			account = forLedgerEntry(c.MustUpdated())
+			account = forLedgerEntry(c.MustUpdated(), owners)
 		case xdr.LedgerEntryChangeTypeLedgerEntryState:
-			account = forLedgerEntry(c.MustState())
+			account = forLedgerEntry(c.MustState(), owners)
 		default:
 			err = fmt.Errorf("Unknown change type: %s", c.Type)
 			return
@@ -128,24 +145,51 @@ func forChanges(
 	return
 }
 
-func forLedgerEntry(le xdr.LedgerEntry) *xdr.AccountId {
-	if le.Data.Type != xdr.LedgerEntryTypeAccount {
+func forLedgerEntry(le xdr.LedgerEntry, owners bool) *xdr.AccountId {
+	var aid xdr.AccountId
+
+	switch {
+	case le.Data.Type == xdr.LedgerEntryTypeAccount:
+		aid = le.Data.MustAccount().AccountId
+	case !owners:
+		return nil
+	case le.Data.Type == xdr.LedgerEntryTypeTrustline:
+		aid = le.Data.MustTrustLine().AccountId
+	case le.Data.Type == xdr.LedgerEntryTypeOffer:
+		aid = le.Data.MustOffer().SellerId
+	case le.Data.Type == xdr.LedgerEntryTypeData:
+		aid = le.Data.MustData().AccountId
+	default:
 		return nil
 	}
-	aid := le.Data.MustAccount().AccountId
+
 	return &aid
 }
 
-func forLedgerKey(lk xdr.LedgerKey) *xdr.AccountId {
-	if lk.Type != xdr.LedgerEntryTypeAccount {
+func forLedgerKey(lk xdr.LedgerKey, owners bool) *xdr.AccountId {
+	var aid xdr.AccountId
+
+	switch {
+	case lk.Type == xdr.LedgerEntryTypeAccount:
+		aid = lk.MustAccount().AccountId
+	case !owners:
+		return nil
+	case lk.Type == xdr.LedgerEntryTypeTrustline:
+		aid = lk.MustTrustLine().AccountId
+	case lk.Type == xdr.LedgerEntryTypeOffer:
+		aid = lk.MustOffer().SellerId
+	case lk.Type == xdr.LedgerEntryTypeData:
+		aid = lk.MustData().AccountId
+	default:
 		return nil
 	}
-	aid := lk.MustAccount().AccountId
+
 	return &aid
 }
 
 func forMeta(
 	meta *xdr.TransactionMeta,
+	owners bool,
 ) (result []xdr.AccountId, err error) {
 
 	if meta.Operations == nil {
@@ -154,7 +198,7 @@ func forMeta(
 
 	for _, op := range *meta.Operations {
 		var acc []xdr.AccountId
-		acc, err = forChanges(&op.Changes)
+		acc, err = forChanges(&op.Changes, owners)
 		if err != nil {
 			return
 		}
@@ -114,6 +114,65 @@ func TestForTransaction(t *testing.T) {
 	tt.Assert.Contains(p, aid("GAXI33UCLQTCKM2NMRBS7XYBR535LLEVAHL5YBN4FTCB4HZHT7ZA5CVK"))
 }
 
+func TestForEntryOwners(t *testing.T) {
+	tt := test.Start(t)
+	defer tt.Finish()
+
+	taker := aid("GBRPYHIL2CI3FNQ4BXLFMNDLFJUNPU2HY3ZMFSHONUCEOASW7QC7OX2H")
+	maker := aid("GAXI33UCLQTCKM2NMRBS7XYBR535LLEVAHL5YBN4FTCB4HZHT7ZA5CVK")
+	removed := aid("GA46VRKBCLI2X6DXLX7AIEVRFLH3UA7XBE3NGNP6O74HQ5LXHMGTV2JB")
+
+	// a taker fills the offer of a maker, whose trustline changes, and
+	// consumes another maker's offer entirely
+	changes := xdr.LedgerEntryChanges{
+		{
+			Type: xdr.LedgerEntryChangeTypeLedgerEntryUpdated,
+			Updated: &xdr.LedgerEntry{Data: xdr.LedgerEntryData{
+				Type:    xdr.LedgerEntryTypeAccount,
+				Account: &xdr.AccountEntry{AccountId: taker},
+			}},
+		},
+		{
+			Type: xdr.LedgerEntryChangeTypeLedgerEntryUpdated,
+			Updated: &xdr.LedgerEntry{Data: xdr.LedgerEntryData{
+				Type:      xdr.LedgerEntryTypeTrustline,
+				TrustLine: &xdr.TrustLineEntry{AccountId: maker},
+			}},
+		},
+		{
+			Type: xdr.LedgerEntryChangeTypeLedgerEntryUpdated,
+			Updated: &xdr.LedgerEntry{Data: xdr.LedgerEntryData{
+				Type:  xdr.LedgerEntryTypeOffer,
+				Offer: &xdr.OfferEntry{SellerId: maker},
+			}},
+		},
+		{
+			Type: xdr.LedgerEntryChangeTypeLedgerEntryRemoved,
+			Removed: &xdr.LedgerKey{
+				Type:  xdr.LedgerEntryTypeOffer,
+				Offer: &xdr.LedgerKeyOffer{SellerId: removed},
+			},
+		},
+	}
+	meta := xdr.TransactionMeta{
+		Operations: &[]xdr.OperationMeta{{Changes: changes}},
+	}
+
+	p, err := ForEntryOwners(&meta)
+	if tt.Assert.NoError(err) {
+		tt.Assert.Len(p, 3)
+		tt.Assert.Contains(p, taker)
+		tt.Assert.Contains(p, maker)
+		tt.Assert.Contains(p, removed)
+	}
+
+	// only the changed account is a participant
+	p, err = forMeta(&meta, false)
+	if tt.Assert.NoError(err) {
+		tt.Assert.Equal([]xdr.AccountId{taker}, p)
+	}
+}
+
 // helper function to convert an address into an accountid
 func aid(addy string) (ret xdr.AccountId) {
 	err := ret.SetAddress(addy)
@@ -0,0 +1,95 @@
+package ingest
+
+import (
+	"fmt"
+	"sync"
+
+	migrate "github.com/rubenv/sql-migrate"
+	"github.com/stellar/go/support/errors"
+)
+
+// Processor derives additional data from the ledgers being ingested, writing
+// it to tables of its own in the horizon database.  Processors are called once
+// the built-in row of each ledger, transaction and operation has been written,
+// and write through the Ingestion's DB so that their rows are committed or
+// rolled back along with the rest of the ledger.
+type Processor interface {
+	// Name identifies the processor.  It must be unique among the registered
+	// processors, and is used to name the table that tracks the processor's
+	// applied migrations.
+	Name() string
+
+	// Migrations returns the schema migrations that create the processor's
+	// tables, or nil if it has none.
+	Migrations() migrate.MigrationSource
+
+	// Tables returns the tables the processor writes to.  Their rows are
+	// removed along with those of the built-in tables when a range of ledgers
+	// is cleared.
+	Tables() []ProcessorTable
+
+	// ProcessLedger is called once the cursor has advanced to a new ledger,
+	// before any of its transactions.
+	ProcessLedger(c *Cursor, ingest *Ingestion) error
+
+	// ProcessTransaction is called for each transaction in a ledger, including
+	// failed transactions, before any of its operations.
+	ProcessTransaction(c *Cursor, ingest *Ingestion) error
+
+	// ProcessOperation is called for each operation in a transaction.
+	ProcessOperation(c *Cursor, ingest *Ingestion) error
+}
+
+// ProcessorTable is a table owned by a Processor.  IDColumn names the column
+// holding the id of the ledger, transaction or operation each row was derived
+// from, as used by the history system, and is used to clear ranges of ledgers.
+type ProcessorTable struct {
+	Name     string
+	IDColumn string
+}
+
+var (
+	processorsLock sync.Mutex
+	processors     []Processor
+)
+
+// RegisterProcessor registers `p` to be run by the ingestion systems created
+// after this call.  It is meant to be called while the app is initialized,
+// and panics if a processor of the same name is already registered.
+func RegisterProcessor(p Processor) {
+	processorsLock.Lock()
+	defer processorsLock.Unlock()
+
+	for _, existing := range processors {
+		if existing.Name() == p.Name() {
+			panic(fmt.Sprintf("ingest: processor %q registered twice", p.Name()))
+		}
+	}
+
+	processors = append(processors, p)
+}
+
+// Processors returns the registered processors, in the order they were
+// registered.
+func Processors() []Processor {
+	processorsLock.Lock()
+	defer processorsLock.Unlock()
+
+	return append([]Processor(nil), processors...)
+}
+
+// runProcessors calls `fn` with each of the session's processors, stopping at
+// the first error.
+func (is *Session) runProcessors(fn func(Processor) error) {
+	if is.Err != nil {
+		return
+	}
+
+	for _, p := range is.Ingestion.Processors {
+		err := fn(p)
+		if err != nil {
+			is.Err = errors.Wrapf(err, "processor %s failed", p.Name())
+			return
+		}
+	}
+}
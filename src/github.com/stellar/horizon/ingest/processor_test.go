@@ -0,0 +1,112 @@
+package ingest
+
+import (
+	"testing"
+
+	sq "github.com/Masterminds/squirrel"
+	migrate "github.com/rubenv/sql-migrate"
+	"github.com/stellar/horizon/test"
+	"github.com/stretchr/testify/assert"
+)
+
+// operationTypesProcessor is a Processor that records the type of each
+// operation it is called with, and counts the ledgers and transactions.
+type operationTypesProcessor struct {
+	name         string
+	ledgers      int
+	transactions int
+}
+
+func (p *operationTypesProcessor) Name() string { return p.name }
+
+func (p *operationTypesProcessor) Migrations() migrate.MigrationSource {
+	return &migrate.MemoryMigrationSource{
+		Migrations: []*migrate.Migration{{
+			Id:   "1_create_test_operation_types",
+			Up:   []string{operationTypesTable},
+			Down: []string{"DROP TABLE test_operation_types;"},
+		}},
+	}
+}
+
+func (p *operationTypesProcessor) Tables() []ProcessorTable {
+	return []ProcessorTable{{Name: "test_operation_types", IDColumn: "history_operation_id"}}
+}
+
+func (p *operationTypesProcessor) ProcessLedger(c *Cursor, ingest *Ingestion) error {
+	p.ledgers++
+	return nil
+}
+
+func (p *operationTypesProcessor) ProcessTransaction(c *Cursor, ingest *Ingestion) error {
+	p.transactions++
+	return nil
+}
+
+func (p *operationTypesProcessor) ProcessOperation(c *Cursor, ingest *Ingestion) error {
+	_, err := ingest.DB.Exec(sq.Insert("test_operation_types").
+		Columns("history_operation_id", "type").
+		Values(c.OperationID(), c.OperationType()))
+	return err
+}
+
+const operationTypesTable = `
+CREATE TABLE IF NOT EXISTS test_operation_types (
+	history_operation_id bigint NOT NULL,
+	type integer NOT NULL
+);`
+
+func TestProcessors(t *testing.T) {
+	tt := test.Start(t).ScenarioWithoutHorizon("kahuna")
+	defer tt.Finish()
+
+	hdb := tt.HorizonSession()
+	_, err := hdb.ExecRaw(operationTypesTable)
+	tt.Require.NoError(err)
+	_, err = hdb.ExecRaw("DELETE FROM test_operation_types")
+	tt.Require.NoError(err)
+
+	p := &operationTypesProcessor{name: "operation_types"}
+	sys := sys(tt)
+	sys.Processors = []Processor{p}
+
+	s := sys.Tick()
+	tt.Require.NoError(s.Err)
+	tt.Assert.Equal(s.Ingested, p.ledgers)
+
+	count := func(table string) (found int) {
+		err := hdb.GetRaw(&found, "SELECT COUNT(*) FROM "+table)
+		tt.Require.NoError(err)
+		return
+	}
+
+	tt.Assert.Equal(count("history_transactions"), p.transactions)
+	tt.Assert.Equal(count("history_operations"), count("test_operation_types"))
+	tt.Assert.NotEqual(0, count("test_operation_types"))
+
+	// clearing history clears the processor's table, too
+	err = sys.ClearAll()
+	tt.Require.NoError(err)
+	tt.Assert.Equal(0, count("test_operation_types"))
+}
+
+func TestRegisterProcessor(t *testing.T) {
+	registered := processors
+	defer func() { processors = registered }()
+	processors = nil
+
+	first := &operationTypesProcessor{name: "first"}
+	second := &operationTypesProcessor{name: "second"}
+
+	RegisterProcessor(first)
+	RegisterProcessor(second)
+	assert.Equal(t, []Processor{first, second}, Processors())
+
+	assert.Panics(t, func() {
+		RegisterProcessor(&operationTypesProcessor{name: "first"})
+	})
+
+	// New uses the registered processors
+	sys := New("", "", nil, nil)
+	assert.Equal(t, []Processor{first, second}, sys.Processors)
+}
@@ -0,0 +1,240 @@
+package ingest
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/stellar/go/support/errors"
+	"github.com/stellar/horizon/db2/core"
+	"github.com/stellar/horizon/log"
+)
+
+const (
+	// ReingestChunkSize is the number of ledgers in each of the chunks that a
+	// parallel reingestion splits its range into.  Chunk boundaries are aligned
+	// to multiples of this value so that repeated runs over the same range
+	// produce the same chunks.
+	ReingestChunkSize = 10000
+)
+
+// ReingestChunk is a contiguous range of ledgers, from `Start` to `End`
+// inclusive, that is reingested by a single session.
+type ReingestChunk struct {
+	Start int32 `db:"start_ledger"`
+	End   int32 `db:"end_ledger"`
+}
+
+// ReingestChunks splits the range from `start` to `end`, inclusive, into chunks
+// whose boundaries are aligned to multiples of `size`.
+func ReingestChunks(start, end, size int32) []ReingestChunk {
+	var chunks []ReingestChunk
+
+	for cur := start; cur <= end; {
+		next := (cur/size + 1) * size
+		if next > end {
+			next = end + 1
+		}
+
+		chunks = append(chunks, ReingestChunk{Start: cur, End: next - 1})
+		cur = next
+	}
+
+	return chunks
+}
+
+// ReingestRangeParallel reingests the ledgers from `start` to `end`, inclusive,
+// using up to `workers` concurrent sessions.  A `start` or `end` of zero
+// defaults to the oldest or newest ledger in the stellar-core database.
+//
+// The range is split into chunks of ReingestChunkSize ledgers, each ingested by
+// an independent session.  Completed chunks are recorded in the horizon
+// database, so running an interrupted reingestion again skips the chunks that
+// were already completed.  The record is removed once the entire range has been
+// reingested.
+func (i *System) ReingestRangeParallel(start, end int32, workers int) (int, error) {
+	return i.reingestRangeParallel(start, end, workers, ReingestChunkSize)
+}
+
+func (i *System) reingestRangeParallel(
+	start, end int32,
+	workers int,
+	size int32,
+) (int, error) {
+	if workers < 1 {
+		return 0, errors.New("reingest: workers must be positive")
+	}
+
+	cq := core.Q{Session: i.CoreDB}
+
+	if start == 0 {
+		err := i.trimAbandondedLedgers()
+		if err != nil {
+			return 0, err
+		}
+
+		err = cq.ElderLedger(&start)
+		if err != nil {
+			return 0, errors.Wrap(err, "load core elder ledger failed")
+		}
+	}
+
+	if end == 0 {
+		err := cq.LatestLedger(&end)
+		if err != nil {
+			return 0, errors.Wrap(err, "load core latest ledger failed")
+		}
+	}
+
+	if start > end {
+		return 0, fmt.Errorf("reingest: invalid range %d-%d", start, end)
+	}
+
+	pending, err := i.pendingReingestChunks(start, end, size)
+	if err != nil {
+		return 0, err
+	}
+
+	log.
+		WithField("start", start).
+		WithField("end", end).
+		WithField("workers", workers).
+		WithField("pending_chunks", len(pending)).
+		Info("reingest: parallel")
+
+	var (
+		wg       sync.WaitGroup
+		lock     sync.Mutex
+		ingested int
+		firstErr error
+	)
+
+	work := make(chan ReingestChunk)
+
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			for chunk := range work {
+				n, err := i.reingestChunk(chunk)
+
+				lock.Lock()
+				ingested += n
+				if err != nil && firstErr == nil {
+					firstErr = err
+				}
+				lock.Unlock()
+			}
+		}()
+	}
+
+	for _, chunk := range pending {
+		lock.Lock()
+		failed := firstErr != nil
+		lock.Unlock()
+
+		// stop handing out chunks after a failure, leaving the completed chunks
+		// recorded so that the next run resumes from them.
+		if failed {
+			break
+		}
+
+		work <- chunk
+	}
+	close(work)
+	wg.Wait()
+
+	if firstErr != nil {
+		return ingested, firstErr
+	}
+
+	return ingested, i.clearReingestProgress(start, end)
+}
+
+// pendingReingestChunks returns the chunks of `size` ledgers of the range from
+// `start` to `end` that have not yet been reingested with the current version
+// of the ingestion algorithm.
+func (i *System) pendingReingestChunks(start, end, size int32) ([]ReingestChunk, error) {
+	var completed []ReingestChunk
+
+	sql := sq.Select("start_ledger", "end_ledger").
+		From("reingest_progress").
+		Where("importer_version = ?", CurrentVersion).
+		Where("start_ledger <= ? AND end_ledger >= ?", end, start)
+
+	err := i.HorizonDB.Select(&completed, sql)
+	if err != nil {
+		return nil, errors.Wrap(err, "load reingest progress failed")
+	}
+
+	var pending []ReingestChunk
+	for _, chunk := range ReingestChunks(start, end, size) {
+		done := false
+		for _, c := range completed {
+			if c.Start <= chunk.Start && c.End >= chunk.End {
+				done = true
+				break
+			}
+		}
+
+		if !done {
+			pending = append(pending, chunk)
+		}
+	}
+
+	return pending, nil
+}
+
+// reingestChunk reingests `chunk` in its own session, and records the chunk as
+// completed once it succeeds.
+func (i *System) reingestChunk(chunk ReingestChunk) (int, error) {
+	n, err := i.reingestRange(chunk.Start, chunk.End, LockShared)
+	if err != nil {
+		return n, errors.Wrap(err, "reingest chunk failed")
+	}
+
+	return n, i.markReingested(chunk)
+}
+
+// markReingested records that `chunk` has been reingested with the current
+// version of the ingestion algorithm.
+func (i *System) markReingested(chunk ReingestChunk) error {
+	hdb := i.HorizonDB.Clone()
+
+	err := hdb.Begin()
+	if err != nil {
+		return errors.Wrap(err, "failed to begin transaction")
+	}
+	defer hdb.Rollback()
+
+	_, err = hdb.Exec(sq.Delete("reingest_progress").Where(sq.Eq{
+		"start_ledger": chunk.Start,
+		"end_ledger":   chunk.End,
+	}))
+	if err != nil {
+		return errors.Wrap(err, "failed to delete reingest progress")
+	}
+
+	_, err = hdb.Exec(sq.Insert("reingest_progress").
+		Columns("start_ledger", "end_ledger", "importer_version", "completed_at").
+		Values(chunk.Start, chunk.End, CurrentVersion, time.Now().UTC()))
+	if err != nil {
+		return errors.Wrap(err, "failed to record reingest progress")
+	}
+
+	return hdb.Commit()
+}
+
+// clearReingestProgress removes the progress recorded for chunks within the
+// range from `start` to `end`.
+func (i *System) clearReingestProgress(start, end int32) error {
+	_, err := i.HorizonDB.Exec(sq.Delete("reingest_progress").
+		Where("start_ledger >= ? AND end_ledger <= ?", start, end))
+	if err != nil {
+		return errors.Wrap(err, "failed to clear reingest progress")
+	}
+
+	return nil
+}
@@ -0,0 +1,75 @@
+package ingest
+
+import (
+	"testing"
+
+	"github.com/stellar/horizon/test"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReingestChunks(t *testing.T) {
+	assert.Equal(t,
+		[]ReingestChunk{{Start: 1, End: 9}, {Start: 10, End: 19}, {Start: 20, End: 25}},
+		ReingestChunks(1, 25, 10),
+	)
+	assert.Equal(t, []ReingestChunk{{Start: 12, End: 12}}, ReingestChunks(12, 12, 10))
+	assert.Empty(t, ReingestChunks(5, 4, 10))
+}
+
+func TestReingestRangeParallel(t *testing.T) {
+	tt := test.Start(t).ScenarioWithoutHorizon("kahuna")
+	defer tt.Finish()
+
+	sys := sys(tt)
+	s := sys.Tick()
+	tt.Require.NoError(s.Err)
+
+	progress := func() (count int) {
+		err := tt.HorizonSession().GetRaw(&count, "SELECT COUNT(*) FROM reingest_progress")
+		tt.Require.NoError(err)
+		return
+	}
+
+	n, err := sys.reingestRangeParallel(0, 0, 4, 10)
+	tt.Require.NoError(err)
+	tt.Assert.Equal(57, n)
+
+	// progress is cleared after the range is complete
+	tt.Assert.Equal(0, progress())
+
+	// chunks completed by an interrupted run are skipped
+	tt.Require.NoError(sys.markReingested(ReingestChunk{Start: 1, End: 9}))
+	tt.Require.NoError(sys.markReingested(ReingestChunk{Start: 20, End: 29}))
+	tt.Assert.Equal(2, progress())
+
+	n, err = sys.reingestRangeParallel(0, 0, 4, 10)
+	tt.Require.NoError(err)
+	tt.Assert.Equal(57-19, n)
+	tt.Assert.Equal(0, progress())
+
+	// explicit ranges
+	n, err = sys.reingestRangeParallel(5, 14, 2, 10)
+	tt.Require.NoError(err)
+	tt.Assert.Equal(10, n)
+
+	_, err = sys.reingestRangeParallel(14, 5, 2, 10)
+	tt.Assert.Error(err)
+}
+
+func TestReingestRangeParallel_SharedRows(t *testing.T) {
+	tt := test.Start(t).ScenarioWithoutHorizon("kahuna")
+	defer tt.Finish()
+
+	// with a chunk per ledger, the concurrent sessions each create the history
+	// accounts and asset stats of the ledgers they ingest
+	n, err := sys(tt).reingestRangeParallel(0, 0, 8, 1)
+	tt.Require.NoError(err)
+	tt.Assert.Equal(57, n)
+
+	var duplicates int
+	err = tt.HorizonSession().GetRaw(&duplicates, `
+		SELECT COUNT(*) - COUNT(DISTINCT address) FROM history_accounts
+	`)
+	tt.Require.NoError(err)
+	tt.Assert.Equal(0, duplicates)
+}
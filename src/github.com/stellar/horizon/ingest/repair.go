@@ -0,0 +1,108 @@
+package ingest
+
+import (
+	metrics "github.com/rcrowley/go-metrics"
+	"github.com/stellar/go/support/errors"
+	"github.com/stellar/horizon/db2/core"
+	"github.com/stellar/horizon/db2/history"
+	"github.com/stellar/horizon/log"
+)
+
+// repairLedgerChain repairs the history database when its latest ledger, at
+// `seq - 1`, is not the parent of the ledger at `seq` in stellar-core.  It
+// finds the latest ledger whose hash is the same in both databases, searching
+// back at most RepairWindow ledgers, and reingests every ledger after it.
+func (i *System) repairLedgerChain(seq int32) error {
+	if i.RepairWindow <= 0 {
+		return errors.New("ledger chain repair is disabled")
+	}
+
+	latest := seq - 1
+	floor := latest - i.RepairWindow
+	if floor < 1 {
+		floor = 1
+	}
+
+	historyHashes := map[int32]string{}
+	coreHashes := map[int32]string{}
+
+	hq := &history.Q{Session: i.HorizonDB}
+	err := hq.LedgerHashes(historyHashes, floor, latest)
+	if err != nil {
+		return errors.Wrap(err, "failed to load history ledger hashes")
+	}
+
+	cq := &core.Q{Session: i.CoreDB}
+	err = cq.LedgerHashes(coreHashes, floor, latest)
+	if err != nil {
+		return errors.Wrap(err, "failed to load core ledger hashes")
+	}
+
+	shared := int32(0)
+	for s := latest; s >= floor; s-- {
+		coreHash, ok := coreHashes[s]
+		if !ok {
+			// stellar-core no longer has the ledger, so the chains cannot be
+			// compared any further back.
+			break
+		}
+
+		if historyHashes[s] == coreHash {
+			shared = s
+			break
+		}
+	}
+
+	if shared == 0 {
+		return errors.Errorf(
+			"no ledger shared with stellar-core in the %d ledgers before %d",
+			latest-floor+1, seq,
+		)
+	}
+
+	if shared == latest {
+		return errors.Errorf(
+			"ledger %d in stellar-core is not a child of ledger %d in stellar-core",
+			seq, latest,
+		)
+	}
+
+	log.
+		WithField("start", shared+1).
+		WithField("end", latest).
+		Warn("ingest: repairing ledger chain")
+
+	_, err = i.ReingestRange(shared+1, latest)
+	if err != nil {
+		return errors.Wrap(err, "failed to reingest ledgers")
+	}
+
+	err = i.validateLedgerChain(seq)
+	if err != nil {
+		return errors.Wrap(err, "ledger chain still broken after reingesting")
+	}
+
+	return nil
+}
+
+// setRepairErr records the result of the latest attempt to repair the ledger
+// chain, as reported by the LedgerChain health check.
+func (i *System) setRepairErr(err error) {
+	i.lock.Lock()
+	defer i.lock.Unlock()
+	i.repairErr = err
+}
+
+// checkLedgerChain updates the LedgerChain health check.
+func (i *System) checkLedgerChain(h metrics.Healthcheck) {
+	i.lock.Lock()
+	err := i.repairErr
+	i.lock.Unlock()
+
+	if err != nil {
+		h.Unhealthy(err)
+		return
+	}
+
+	h.Healthy()
+}
@@ -0,0 +1,108 @@
+package ingest
+
+import (
+	"testing"
+
+	"github.com/stellar/horizon/db2/core"
+	"github.com/stellar/horizon/db2/history"
+	"github.com/stellar/horizon/ledger"
+	"github.com/stellar/horizon/test"
+	"github.com/stellar/horizon/toid"
+)
+
+// breakLedgerChain simulates a history database that is missing the latest
+// `missing` ledgers, and whose `broken` ledgers before those diverged from
+// stellar-core.
+func breakLedgerChain(tt *test.T, broken, missing int32) {
+	hdb := tt.HorizonSession()
+	latest := ledger.CurrentState().CoreLatest
+
+	ingestion := &Ingestion{DB: hdb.Clone()}
+	tt.Require.NoError(ingestion.Start())
+	tt.Require.NoError(ingestion.Clear(
+		toid.New(latest-missing+1, 0, 0).ToInt64(),
+		toid.New(latest+1, 0, 0).ToInt64(),
+	))
+	tt.Require.NoError(ingestion.Close())
+
+	_, err := hdb.ExecRaw(
+		"UPDATE history_ledgers SET ledger_hash = md5(ledger_hash) || md5(ledger_hash) WHERE sequence > ?",
+		latest-missing-broken,
+	)
+	tt.Require.NoError(err)
+
+	tt.UpdateLedgerState()
+}
+
+func TestRepairLedgerChain(t *testing.T) {
+	tt := test.Start(t).Scenario("kahuna")
+	defer tt.Finish()
+
+	breakLedgerChain(tt, 3, 2)
+	ls := ledger.CurrentState()
+
+	s := sys(tt)
+	is := s.Tick()
+	tt.Require.NoError(is.Err)
+	tt.Assert.Equal(2, is.Ingested)
+	tt.Assert.Equal(int64(1), s.Metrics.LedgerChainRepairs.Count())
+	tt.Assert.Equal(int64(0), s.Metrics.LedgerChainRepairFailures.Count())
+
+	s.Metrics.LedgerChain.Check()
+	tt.Assert.NoError(s.Metrics.LedgerChain.Error())
+
+	// every ledger now matches stellar-core
+	historyHashes := map[int32]string{}
+	coreHashes := map[int32]string{}
+
+	hq := &history.Q{Session: tt.HorizonSession()}
+	tt.Require.NoError(hq.LedgerHashes(historyHashes, ls.HistoryElder, ls.CoreLatest))
+	cq := &core.Q{Session: tt.CoreSession()}
+	tt.Require.NoError(cq.LedgerHashes(coreHashes, ls.HistoryElder, ls.CoreLatest))
+
+	tt.Assert.Equal(coreHashes, historyHashes)
+}
+
+func TestRepairLedgerChain_OutsideWindow(t *testing.T) {
+	tt := test.Start(t).Scenario("kahuna")
+	defer tt.Finish()
+
+	breakLedgerChain(tt, 5, 2)
+	ls := ledger.CurrentState()
+
+	s := sys(tt)
+	s.RepairWindow = 3
+
+	is := s.Tick()
+	tt.Require.NoError(is.Err)
+	tt.Assert.Equal(0, is.Ingested)
+	tt.Assert.Equal(int64(0), s.Metrics.LedgerChainRepairs.Count())
+	tt.Assert.Equal(int64(1), s.Metrics.LedgerChainRepairFailures.Count())
+
+	s.Metrics.LedgerChain.Check()
+	tt.Assert.Error(s.Metrics.LedgerChain.Error())
+
+	// nothing was reingested
+	var latest int32
+	hq := &history.Q{Session: tt.HorizonSession()}
+	tt.Require.NoError(hq.LatestLedger(&latest))
+	tt.Assert.Equal(ls.HistoryLatest, latest)
+}
+
+func TestRepairLedgerChain_Disabled(t *testing.T) {
+	tt := test.Start(t).Scenario("kahuna")
+	defer tt.Finish()
+
+	breakLedgerChain(tt, 1, 1)
+
+	s := sys(tt)
+	s.RepairWindow = 0
+
+	is := s.Tick()
+	tt.Require.NoError(is.Err)
+	tt.Assert.Equal(0, is.Ingested)
+	tt.Assert.Equal(int64(1), s.Metrics.LedgerChainRepairFailures.Count())
+
+	s.Metrics.LedgerChain.Check()
+	tt.Assert.Error(s.Metrics.LedgerChain.Error())
+}
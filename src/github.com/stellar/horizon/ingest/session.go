@@ -7,6 +7,7 @@ import (
 	"net/http"
 	"net/url"
 	"path"
+	"sort"
 	"strings"
 	"time"
 
@@ -14,6 +15,8 @@ import (
 	"github.com/stellar/go/keypair"
 	"github.com/stellar/go/meta"
 	"github.com/stellar/go/xdr"
+	"github.com/stellar/horizon/bus"
+	"github.com/stellar/horizon/db2/core"
 	"github.com/stellar/horizon/db2/history"
 	"github.com/stellar/horizon/ingest/participants"
 )
@@ -21,6 +24,9 @@ import (
 // Run starts an attempt to ingest the range of ledgers specified in this
 // session.
 func (is *Session) Run() {
+	is.events = nil
+	is.Ingestion.Reingest = is.ClearExisting
+
 	is.Err = is.Ingestion.Start()
 	if is.Err != nil {
 		return
@@ -31,6 +37,7 @@ func (is *Session) Run() {
 	for is.Cursor.NextLedger() {
 		is.clearLedger()
 		is.ingestLedger()
+		is.queueEvent()
 		is.flush()
 
 		if is.Err != nil {
@@ -53,6 +60,7 @@ func (is *Session) Run() {
 		return
 	}
 
+	is.publish()
 	is.Err = is.reportCursorState()
 }
 
@@ -94,6 +102,66 @@ func (is *Session) flush() {
 	is.Err = is.Ingestion.Flush()
 }
 
+// ingestAssetStats refreshes the `asset_stats` rows of the assets and issuers
+// tracked while ingesting the current ledger, using the current state of the
+// stellar-core database.  The table describes the ledger as it is now rather
+// than as of the ledger ingested, and so reingesting an old ledger refreshes
+// its assets with their present-day totals, which are the totals the table
+// should hold.
+func (is *Session) ingestAssetStats() {
+	if is.Err != nil {
+		return
+	}
+
+	cq := core.Q{Session: is.Cursor.DB}
+
+	// visit the assets in a stable order so that concurrent sessions update
+	// rows in the same order.
+	keys := make([]string, 0, len(is.assetStats))
+	for key := range is.assetStats {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		var stat core.AssetStat
+		is.Err = cq.AssetStatByAsset(&stat, is.assetStats[key])
+		if is.Err != nil {
+			return
+		}
+
+		is.Err = is.Ingestion.AssetStat(is.assetStats[key], stat)
+		if is.Err != nil {
+			return
+		}
+	}
+
+	issuers := make([]string, 0, len(is.assetIssuers))
+	for issuer := range is.assetIssuers {
+		issuers = append(issuers, issuer)
+	}
+	sort.Strings(issuers)
+
+	for _, issuer := range issuers {
+		var account core.Account
+		err := cq.AccountByAddress(&account, issuer)
+		if cq.NoRows(err) {
+			// the issuer has since been merged away
+			continue
+		}
+
+		if err != nil {
+			is.Err = err
+			return
+		}
+
+		is.Err = is.Ingestion.AssetStatFlags(issuer, account.Flags)
+		if is.Err != nil {
+			return
+		}
+	}
+}
+
 func (is *Session) ingestEffects() {
 	if is.Err != nil {
 		return
@@ -140,9 +208,11 @@ func (is *Session) ingestEffects() {
 	case xdr.OperationTypePathPayment:
 		result := is.Cursor.OperationResult().MustPathPaymentResult().MustSuccess()
 		is.ingestTradeEffects(effects, source, result.Offers)
+		is.ingestOfferEffects(effects)
 	case xdr.OperationTypeManageOffer:
 		result := is.Cursor.OperationResult().MustManageOfferResult().MustSuccess()
 		is.ingestTradeEffects(effects, source, result.OffersClaimed)
+		is.ingestOfferEffects(effects)
 	case xdr.OperationTypeCreatePassiveOffer:
 		claims := []xdr.ClaimOfferAtom{}
 		result := is.Cursor.OperationResult()
@@ -156,6 +226,7 @@ func (is *Session) ingestEffects() {
 		}
 
 		is.ingestTradeEffects(effects, source, claims)
+		is.ingestOfferEffects(effects)
 	case xdr.OperationTypeSetOptions:
 		op := opbody.MustSetOptionsOp()
 
@@ -323,10 +394,27 @@ func (is *Session) ingestLedger() {
 		return
 	}
 
+	is.event = bus.Event{}
+	is.accounts = map[string]bool{}
+	is.assetStats = map[string]xdr.Asset{}
+	is.assetIssuers = map[string]bool{}
+
+	is.runProcessors(func(p Processor) error {
+		return p.ProcessLedger(is.Cursor, is.Ingestion)
+	})
+	if is.Err != nil {
+		return
+	}
+
 	for is.Cursor.NextTx() {
 		is.ingestTransaction()
 	}
 
+	is.ingestAssetStats()
+	if is.Err != nil {
+		return
+	}
+
 	is.Ingested++
 	if is.Metrics != nil {
 		is.Metrics.IngestLedgerTimer.Update(time.Since(start))
@@ -353,8 +441,120 @@ func (is *Session) ingestOperation() {
 	}
 
 	is.ingestOperationParticipants()
+	is.runProcessors(func(p Processor) error {
+		return p.ProcessOperation(is.Cursor, is.Ingestion)
+	})
+
+	// operations of a failed transaction are never applied to the ledger, and
+	// so they have no effects or trades.
+	if !is.Cursor.Transaction().IsSuccessful() {
+		return
+	}
+
 	is.ingestEffects()
 	is.ingestTrades()
+	is.trackAssetStats()
+}
+
+// ingestOfferEffects adds an offer_created, offer_updated or offer_removed
+// effect for every offer whose ledger entry was changed by the current
+// operation.  The effect is attributed to the account that owns the offer.
+func (is *Session) ingestOfferEffects(effects *EffectIngestion) {
+	if is.Err != nil {
+		return
+	}
+
+	for _, key := range is.operationOfferKeys() {
+		before, after, err := is.Cursor.BeforeAndAfter(key)
+		if err != nil {
+			is.Err = err
+			return
+		}
+
+		var (
+			effect history.EffectType
+			offer  xdr.OfferEntry
+		)
+
+		switch {
+		case before == nil && after != nil:
+			effect = history.EffectOfferCreated
+			offer = after.Data.MustOffer()
+		case before != nil && after == nil:
+			effect = history.EffectOfferRemoved
+			offer = before.Data.MustOffer()
+		case before != nil && after != nil:
+			effect = history.EffectOfferUpdated
+			offer = after.Data.MustOffer()
+		default:
+			// the offer was created and removed by the same operation, which
+			// never results in a visible offer.
+			continue
+		}
+
+		dets := map[string]interface{}{
+			"offer_id": offer.OfferId,
+			"amount":   amount.String(offer.Amount),
+			"price":    offer.Price.String(),
+			"price_r": map[string]interface{}{
+				"n": offer.Price.N,
+				"d": offer.Price.D,
+			},
+		}
+		is.assetDetails(dets, offer.Selling, "selling_")
+		is.assetDetails(dets, offer.Buying, "buying_")
+
+		effects.Add(offer.SellerId, effect, dets)
+	}
+}
+
+// operationOfferKeys returns the ledger keys of the offers changed by the
+// current operation, in the order they first appear in the operation's meta.
+func (is *Session) operationOfferKeys() []xdr.LedgerKey {
+	var keys []xdr.LedgerKey
+	seen := map[string]bool{}
+
+	for _, change := range is.Cursor.OperationChanges() {
+		var key xdr.LedgerKey
+
+		switch change.Type {
+		case xdr.LedgerEntryChangeTypeLedgerEntryCreated:
+			key = offerKey(change.MustCreated().Data)
+		case xdr.LedgerEntryChangeTypeLedgerEntryUpdated:
+			key = offerKey(change.MustUpdated().Data)
+		case xdr.LedgerEntryChangeTypeLedgerEntryState:
+			key = offerKey(change.MustState().Data)
+		case xdr.LedgerEntryChangeTypeLedgerEntryRemoved:
+			key = change.MustRemoved()
+		}
+
+		if key.Type != xdr.LedgerEntryTypeOffer {
+			continue
+		}
+
+		o := key.MustOffer()
+		id := fmt.Sprintf("%s-%d", o.SellerId.Address(), o.OfferId)
+		if seen[id] {
+			continue
+		}
+
+		seen[id] = true
+		keys = append(keys, key)
+	}
+
+	return keys
+}
+
+// offerKey returns the ledger key for `data` if it is an offer, or an empty
+// key otherwise.
+func offerKey(data xdr.LedgerEntryData) (key xdr.LedgerKey) {
+	offer, ok := data.GetOffer()
+	if !ok {
+		return
+	}
+
+	key.SetOffer(offer.SellerId, uint64(offer.OfferId))
+	return
 }
 
 func (is *Session) ingestOperationParticipants() {
@@ -514,10 +714,6 @@ func (is *Session) ingestTransaction() {
 		return
 	}
 
-	// skip ingesting failed transactions
-	if !is.Cursor.Transaction().IsSuccessful() {
-		return
-	}
 	is.Err = is.Ingestion.Transaction(
 		is.Cursor.TransactionID(),
 		is.Cursor.Transaction(),
@@ -527,6 +723,10 @@ func (is *Session) ingestTransaction() {
 		return
 	}
 
+	is.runProcessors(func(p Processor) error {
+		return p.ProcessTransaction(is.Cursor, is.Ingestion)
+	})
+
 	for is.Cursor.NextOp() {
 		is.ingestOperation()
 	}
@@ -555,6 +755,24 @@ func (is *Session) ingestTransactionParticipants() {
 		return
 	}
 
+	// streams of the accounts whose trustlines and offers changed are woken
+	// too, such as those of the makers of the offers the transaction filled
+	var owners []xdr.AccountId
+	owners, is.Err = participants.ForEntryOwners(&is.Cursor.Transaction().ResultMeta)
+	if is.Err != nil {
+		return
+	}
+
+	for _, aid := range append(p, owners...) {
+		address := aid.Address()
+		if is.accounts[address] {
+			continue
+		}
+
+		is.accounts[address] = true
+		is.event.Accounts = append(is.event.Accounts, address)
+	}
+
 }
 
 // assetDetails sets the details for `a` on `result` using keys with `prefix`
@@ -603,11 +821,16 @@ func (is *Session) operationDetails() map[string]interface{} {
 		details["from"] = source.Address()
 		details["to"] = op.Destination.Address()
 
-		result := c.OperationResult().MustPathPaymentResult()
-
 		details["amount"] = amount.String(op.DestAmount)
-		details["source_amount"] = amount.String(result.SendAmount())
 		details["source_max"] = amount.String(op.SendMax)
+
+		// a failed path payment has no result from which to derive the amount
+		// that was actually sent.
+		if c.Transaction().IsSuccessful() {
+			result := c.OperationResult().MustPathPaymentResult()
+			details["source_amount"] = amount.String(result.SendAmount())
+		}
+
 		is.assetDetails(details, op.DestAsset, "")
 		is.assetDetails(details, op.SendAsset, "source_")
 
@@ -737,6 +960,35 @@ func (is *Session) operationFlagDetails(result map[string]interface{}, f int32,
 	result[prefix+"_flags_s"] = s
 }
 
+// queueEvent completes the event of the ledger that was just ingested and
+// queues it to be published once the session commits.  Ledgers that are being
+// reingested are not published, as streams have already seen them.
+func (is *Session) queueEvent() {
+	if is.Err != nil || is.ClearExisting {
+		return
+	}
+
+	q := history.Q{Session: is.Ingestion.DB}
+	is.Err = q.LedgerBySequence(&is.event.Ledger, is.Cursor.LedgerSequence())
+	if is.Err != nil {
+		return
+	}
+
+	is.events = append(is.events, is.event)
+}
+
+// publish announces the ledgers queued by the session on the bus, so that
+// streaming requests watching them can respond.  It is called once the
+// session's transaction has been committed, so that the requests woken by an
+// event can load the ledger's rows through their own connections.
+func (is *Session) publish() {
+	for _, event := range is.events {
+		bus.Publish(event)
+	}
+
+	is.events = nil
+}
+
 // reportCursorState makes an http request to the configured stellar-core server
 // to report that it has finished processing the data being ingested.  This
 // allows stellar-core to free that storage when next it runs its own
@@ -782,3 +1034,50 @@ func (is *Session) reportCursorState() error {
 
 	return nil
 }
+
+// trackAssetStats records the assets whose trustlines were changed by the
+// current operation, and the issuer whose flags were changed by it, so that
+// their statistics can be refreshed once the ledger has been ingested.
+func (is *Session) trackAssetStats() {
+	if is.Err != nil {
+		return
+	}
+
+	for _, change := range is.Cursor.OperationChanges() {
+		var (
+			asset xdr.Asset
+			ok    bool
+		)
+
+		switch change.Type {
+		case xdr.LedgerEntryChangeTypeLedgerEntryCreated:
+			var tl xdr.TrustLineEntry
+			tl, ok = change.MustCreated().Data.GetTrustLine()
+			asset = tl.Asset
+		case xdr.LedgerEntryChangeTypeLedgerEntryUpdated:
+			var tl xdr.TrustLineEntry
+			tl, ok = change.MustUpdated().Data.GetTrustLine()
+			asset = tl.Asset
+		case xdr.LedgerEntryChangeTypeLedgerEntryRemoved:
+			var key xdr.LedgerKeyTrustLine
+			key, ok = change.MustRemoved().GetTrustLine()
+			asset = key.Asset
+		}
+
+		if !ok {
+			continue
+		}
+
+		is.assetStats[asset.String()] = asset
+	}
+
+	if is.Cursor.OperationType() != xdr.OperationTypeSetOptions {
+		return
+	}
+
+	op := is.Cursor.Operation().Body.MustSetOptionsOp()
+	if op.SetFlags != nil || op.ClearFlags != nil {
+		source := is.Cursor.OperationSourceAccount()
+		is.assetIssuers[source.Address()] = true
+	}
+}
@@ -1,6 +1,7 @@
 package ingest
 
 import (
+	sq "github.com/Masterminds/squirrel"
 	"github.com/stellar/go/support/errors"
 	err2 "github.com/stellar/go/support/errors"
 	"github.com/stellar/horizon/db2/core"
@@ -16,7 +17,7 @@ import (
 func (i *System) ClearAll() error {
 
 	hdb := i.HorizonDB.Clone()
-	ingestion := &Ingestion{DB: hdb}
+	ingestion := &Ingestion{DB: hdb, Processors: i.Processors, Lock: i.LockMode}
 
 	err := ingestion.Start()
 	if err != nil {
@@ -28,6 +29,16 @@ func (i *System) ClearAll() error {
 		return errors.Wrap(err, "failed to clear history tables")
 	}
 
+	_, err = hdb.Exec(sq.Delete("reingest_progress"))
+	if err != nil {
+		return errors.Wrap(err, "failed to clear reingest progress")
+	}
+
+	_, err = hdb.Exec(sq.Delete("asset_stats"))
+	if err != nil {
+		return errors.Wrap(err, "failed to clear asset stats")
+	}
+
 	err = ingestion.Close()
 	if err != nil {
 		return errors.Wrap(err, "failed to close ingestion")
@@ -139,8 +150,15 @@ func (i *System) ReingestOutdated() (n int, err error) {
 
 // ReingestRange reingests a range of ledgers, from `start` to `end`, inclusive.
 func (i *System) ReingestRange(start, end int32) (int, error) {
+	return i.reingestRange(start, end, i.LockMode)
+}
+
+// reingestRange reingests a range of ledgers, taking the ingestion lock in
+// mode `lock`.
+func (i *System) reingestRange(start, end int32, lock LockMode) (int, error) {
 	is := NewSession(start, end, i)
 	is.ClearExisting = true
+	is.Ingestion.Lock = lock
 
 	is.Run()
 	log.WithField("start", start).
@@ -160,6 +178,10 @@ func (i *System) ReingestSingle(sequence int32) error {
 // Tick triggers the ingestion system to ingest any new ledger data, provided
 // that there currently is not an import session in progress.
 func (i *System) Tick() *Session {
+	if !i.campaign() {
+		return nil
+	}
+
 	i.lock.Lock()
 	if i.current != nil {
 		log.Info("ingest: already in progress")
@@ -175,6 +197,45 @@ func (i *System) Tick() *Session {
 	return is
 }
 
+// Role returns whether the system ingests, as RoleLeader, or stands by, as
+// RoleStandby.  A system without an Election is always the leader.
+func (i *System) Role() string {
+	if i.Election == nil || i.Election.IsLeader() {
+		return RoleLeader
+	}
+
+	return RoleStandby
+}
+
+// campaign returns whether the system is the leader and should ingest.
+func (i *System) campaign() bool {
+	if i.Election == nil {
+		i.Metrics.Leader.Update(1)
+		return true
+	}
+
+	was := i.Election.IsLeader()
+	leader, err := i.Election.Campaign()
+	if err != nil {
+		log.Errorf("ingest: leader election failed: %s", err)
+	}
+
+	switch {
+	case leader && !was:
+		log.Info("ingest: elected leader, starting ingestion")
+	case !leader && was:
+		log.Warn("ingest: no longer leader, standing by")
+	}
+
+	if leader {
+		i.Metrics.Leader.Update(1)
+	} else {
+		i.Metrics.Leader.Update(0)
+	}
+
+	return leader
+}
+
 // newTickSession creates an unverified new ingestion session that reflects the
 // current cached ledger state.
 func (i *System) newTickSession() *Session {
@@ -227,6 +288,14 @@ func (i *System) runOnce() {
 		return
 	}
 
+	// seeding is checked on every run, as the table is emptied by `horizon db
+	// clear` while servers are running
+	err := i.seedAssetStats()
+	if err != nil {
+		log.Errorf("asset stats seeding failed: %s", err)
+		return
+	}
+
 	if is.Cursor.FirstLedger > is.Cursor.LastLedger {
 		return
 	}
@@ -245,7 +314,20 @@ func (i *System) runOnce() {
 			log.
 				WithField("start", is.Cursor.FirstLedger).
 				Errorf("ledger gap detected (possible db corruption): %s", err)
-			return
+
+			err = i.repairLedgerChain(is.Cursor.FirstLedger)
+			i.setRepairErr(err)
+			if err != nil {
+				i.Metrics.LedgerChainRepairFailures.Mark(1)
+				log.
+					WithField("start", is.Cursor.FirstLedger).
+					Errorf("ledger chain repair failed: %s", err)
+				return
+			}
+
+			i.Metrics.LedgerChainRepairs.Mark(1)
+		} else {
+			i.setRepairErr(nil)
 		}
 	}
 
@@ -274,7 +356,7 @@ func (i *System) trimAbandondedLedgers() error {
 	}
 
 	hdb := i.HorizonDB.Clone()
-	ingestion := &Ingestion{DB: hdb}
+	ingestion := &Ingestion{DB: hdb, Processors: i.Processors, Lock: i.LockMode}
 
 	err = ingestion.Start()
 	if err != nil {
@@ -298,21 +380,23 @@ func (i *System) trimAbandondedLedgers() error {
 }
 
 // validateLedgerChain helps to ensure the chain of ledger entries is contiguous
-// within horizon.  It ensures the ledger at `seq` is a child of `seq - 1`.
+// within horizon.  It ensures the ledger at `seq` in stellar-core is a child of
+// the ledger at `seq - 1` in the history database.
 func (i *System) validateLedgerChain(seq int32) error {
 	var (
 		cur  core.LedgerHeader
-		prev core.LedgerHeader
+		prev history.Ledger
 	)
 
-	q := &core.Q{i.CoreDB}
+	cq := &core.Q{Session: i.CoreDB}
+	hq := &history.Q{Session: i.HorizonDB}
 
-	err := q.LedgerHeaderBySequence(&cur, seq)
+	err := cq.LedgerHeaderBySequence(&cur, seq)
 	if err != nil {
 		return err2.Wrap(err, "validateLedgerChain: failed to load cur ledger")
 	}
 
-	err = q.LedgerHeaderBySequence(&prev, seq-1)
+	err = hq.LedgerBySequence(&prev, seq-1)
 	if err != nil {
 		return err2.Wrap(err, "validateLedgerChain: failed to load prev ledger")
 	}
@@ -0,0 +1,402 @@
+package ingest
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/guregu/null"
+	"github.com/stellar/go/support/errors"
+	"github.com/stellar/go/xdr"
+	"github.com/stellar/horizon/db2/core"
+	"github.com/stellar/horizon/db2/history"
+)
+
+// VerifyMismatch describes a row of the history database that differs from
+// stellar-core's data, or from what the ingestion system derives from it.
+type VerifyMismatch struct {
+	Table  string `json:"table"`
+	Ledger int32  `json:"ledger"`
+	// Key identifies the row within the ledger: a transaction hash, or the
+	// paging token of an effect or trade.
+	Key    string `json:"key,omitempty"`
+	Reason string `json:"reason"`
+}
+
+// VerifyReport is the result of verifying a range of ledgers.
+type VerifyReport struct {
+	Start      int32            `json:"start"`
+	End        int32            `json:"end"`
+	Verified   int              `json:"verified"`
+	Mismatches []VerifyMismatch `json:"mismatches"`
+}
+
+// Ledgers returns the sequences of the ledgers with mismatches, in the order
+// they were verified.
+func (r *VerifyReport) Ledgers() []int32 {
+	seen := map[int32]bool{}
+	seqs := []int32{}
+
+	for _, m := range r.Mismatches {
+		if seen[m.Ledger] {
+			continue
+		}
+		seen[m.Ledger] = true
+		seqs = append(seqs, m.Ledger)
+	}
+
+	return seqs
+}
+
+// Tables returns the number of mismatches found in each table.
+func (r *VerifyReport) Tables() map[string]int {
+	counts := map[string]int{}
+	for _, m := range r.Mismatches {
+		counts[m.Table]++
+	}
+	return counts
+}
+
+func (r *VerifyReport) add(table string, seq int32, key string, format string, args ...interface{}) {
+	r.Mismatches = append(r.Mismatches, VerifyMismatch{
+		Table:  table,
+		Ledger: seq,
+		Key:    key,
+		Reason: fmt.Sprintf(format, args...),
+	})
+}
+
+// VerifyRange compares the history of the ledgers from `start` to `end`,
+// inclusive, with stellar-core.  Ledger and transaction rows are compared with
+// the `ledgerheaders` and `txhistory` tables, while effects and trades are
+// compared with those derived from stellar-core's data in memory.  Verifying
+// neither writes to the history database nor takes the ingestion lock, and so
+// it can be run alongside a live ingester.
+func (i *System) VerifyRange(start, end int32) (*VerifyReport, error) {
+	report := &VerifyReport{
+		Start:      start,
+		End:        end,
+		Mismatches: []VerifyMismatch{},
+	}
+
+	for seq := start; seq <= end; seq++ {
+		err := i.verifyLedger(report, seq)
+		if err != nil {
+			return report, errors.Wrapf(err, "failed to verify ledger %d", seq)
+		}
+		report.Verified++
+	}
+
+	return report, nil
+}
+
+func (i *System) verifyLedger(report *VerifyReport, seq int32) error {
+	cq := &core.Q{Session: i.CoreDB}
+	hq := &history.Q{Session: i.HorizonDB}
+
+	var header core.LedgerHeader
+	err := cq.LedgerHeaderBySequence(&header, seq)
+	if err != nil {
+		return errors.Wrap(err, "failed to load core ledger")
+	}
+
+	var txs []core.Transaction
+	err = cq.TransactionsByLedger(&txs, seq)
+	if err != nil {
+		return errors.Wrap(err, "failed to load core transactions")
+	}
+
+	var ledger history.Ledger
+	err = hq.LedgerBySequence(&ledger, seq)
+	if hq.NoRows(err) {
+		report.add("history_ledgers", seq, "", "missing")
+		return nil
+	}
+	if err != nil {
+		return errors.Wrap(err, "failed to load history ledger")
+	}
+
+	i.verifyLedgerRow(report, seq, &ledger, &header, txs)
+
+	err = i.verifyTransactions(report, seq, txs)
+	if err != nil {
+		return err
+	}
+
+	return i.verifyDerived(report, seq)
+}
+
+func (i *System) verifyLedgerRow(
+	report *VerifyReport,
+	seq int32,
+	ledger *history.Ledger,
+	header *core.LedgerHeader,
+	txs []core.Transaction,
+) {
+	const table = "history_ledgers"
+
+	var txCount, opCount int32
+	for idx := range txs {
+		if !txs[idx].IsSuccessful() {
+			continue
+		}
+		txCount++
+		opCount += int32(len(txs[idx].Envelope.Tx.Operations))
+	}
+
+	if ledger.LedgerHash != header.LedgerHash {
+		report.add(table, seq, "", "ledger_hash is %s, expected %s", ledger.LedgerHash, header.LedgerHash)
+	}
+
+	if seq > 1 && ledger.PreviousLedgerHash.String != header.PrevHash {
+		report.add(table, seq, "", "previous_ledger_hash is %s, expected %s", ledger.PreviousLedgerHash.String, header.PrevHash)
+	}
+
+	if ledger.TransactionCount != txCount {
+		report.add(table, seq, "", "transaction_count is %d, expected %d", ledger.TransactionCount, txCount)
+	}
+
+	if ledger.OperationCount != opCount {
+		report.add(table, seq, "", "operation_count is %d, expected %d", ledger.OperationCount, opCount)
+	}
+}
+
+func (i *System) verifyTransactions(report *VerifyReport, seq int32, txs []core.Transaction) error {
+	const table = "history_transactions"
+
+	var stored []history.Transaction
+	hq := &history.Q{Session: i.HorizonDB}
+	err := hq.Transactions().ForLedger(seq).IncludeFailed().Select(&stored)
+	if err != nil {
+		return errors.Wrap(err, "failed to load history transactions")
+	}
+
+	byHash := map[string]history.Transaction{}
+	for _, tx := range stored {
+		byHash[tx.TransactionHash] = tx
+	}
+
+	for idx := range txs {
+		tx := &txs[idx]
+		hash := tx.TransactionHash
+
+		row, ok := byHash[hash]
+		if !ok {
+			report.add(table, seq, hash, "missing")
+			continue
+		}
+		delete(byHash, hash)
+
+		if row.ApplicationOrder != tx.Index {
+			report.add(table, seq, hash, "application_order is %d, expected %d", row.ApplicationOrder, tx.Index)
+		}
+
+		if row.TxResult != tx.ResultXDR() {
+			report.add(table, seq, hash, "tx_result differs from stellar-core")
+		}
+
+		if row.Successful != tx.IsSuccessful() {
+			report.add(table, seq, hash, "successful is %t, expected %t", row.Successful, tx.IsSuccessful())
+		}
+	}
+
+	for _, tx := range stored {
+		if _, extra := byHash[tx.TransactionHash]; extra {
+			report.add(table, seq, tx.TransactionHash, "not in stellar-core")
+		}
+	}
+
+	return nil
+}
+
+// verifyDerived compares the stored effects and trades of ledger `seq` with
+// those derived from stellar-core's data.
+func (i *System) verifyDerived(report *VerifyReport, seq int32) error {
+	var (
+		storedEffects []history.Effect
+		storedTrades  []history.Trade
+		derived       derivation
+	)
+
+	hq := &history.Q{Session: i.HorizonDB}
+	err := hq.Effects().ForLedger(seq).Select(&storedEffects)
+	if err != nil {
+		return errors.Wrap(err, "failed to load history effects")
+	}
+
+	err = hq.Trades().ForLedger(seq).Select(&storedTrades)
+	if err != nil {
+		return errors.Wrap(err, "failed to load history trades")
+	}
+
+	err = i.deriveLedger(seq, &derived)
+	if err != nil {
+		return errors.Wrap(err, "failed to derive ledger")
+	}
+
+	stored := map[string]history.Effect{}
+	for _, e := range storedEffects {
+		stored[e.PagingToken()] = e
+	}
+
+	for _, e := range derived.Effects {
+		key := e.PagingToken()
+		s, ok := stored[key]
+		if !ok {
+			report.add("history_effects", seq, key, "missing")
+			continue
+		}
+		delete(stored, key)
+
+		if s.Account != e.Account || s.Type != e.Type || !sameDetails(s.DetailsString, e.DetailsString) {
+			report.add("history_effects", seq, key, "differs from derived effect")
+		}
+	}
+
+	for _, e := range storedEffects {
+		if _, extra := stored[e.PagingToken()]; extra {
+			report.add("history_effects", seq, e.PagingToken(), "not derived from stellar-core")
+		}
+	}
+
+	trades := map[string]history.Trade{}
+	for _, t := range storedTrades {
+		trades[t.PagingToken()] = t
+	}
+
+	for _, t := range derived.Trades {
+		key := t.PagingToken()
+		s, ok := trades[key]
+		if !ok {
+			report.add("history_trades", seq, key, "missing")
+			continue
+		}
+		delete(trades, key)
+
+		if s != t {
+			report.add("history_trades", seq, key, "differs from derived trade")
+		}
+	}
+
+	for _, t := range storedTrades {
+		if _, extra := trades[t.PagingToken()]; extra {
+			report.add("history_trades", seq, t.PagingToken(), "not derived from stellar-core")
+		}
+	}
+
+	return nil
+}
+
+// deriveLedger derives the effects and trades of ledger `seq` from
+// stellar-core's data, without writing to the history database or taking the
+// ingestion lock.
+func (i *System) deriveLedger(seq int32, d *derivation) error {
+	is := NewSession(seq, seq, i)
+	is.Metrics = nil
+	is.Cursor.Metrics = nil
+	is.Ingestion.derived = d
+
+	if !is.Cursor.NextLedger() {
+		if is.Cursor.Err != nil {
+			return is.Cursor.Err
+		}
+		return errors.New("ledger not found in stellar-core")
+	}
+
+	for is.Cursor.NextTx() {
+		if !is.Cursor.Transaction().IsSuccessful() {
+			continue
+		}
+
+		for is.Cursor.NextOp() {
+			is.ingestEffects()
+			is.ingestTrades()
+		}
+	}
+
+	if is.Cursor.Err != nil {
+		return is.Cursor.Err
+	}
+	return is.Err
+}
+
+// derivation collects the effects and trades of an ingestion in memory, in
+// place of the rows it would write to the history database.
+type derivation struct {
+	Effects []history.Effect
+	Trades  []history.Trade
+}
+
+func (d *derivation) effect(
+	aid xdr.AccountId,
+	opid int64,
+	order int,
+	typ history.EffectType,
+	details interface{},
+) error {
+	djson, err := json.Marshal(details)
+	if err != nil {
+		return err
+	}
+
+	d.Effects = append(d.Effects, history.Effect{
+		Account:            aid.Address(),
+		HistoryOperationID: opid,
+		Order:              int32(order),
+		Type:               typ,
+		DetailsString:      null.StringFrom(string(djson)),
+	})
+	return nil
+}
+
+func (d *derivation) trade(
+	opid int64,
+	order int32,
+	buyer xdr.AccountId,
+	trade xdr.ClaimOfferAtom,
+) error {
+	t := history.Trade{
+		HistoryOperationID: opid,
+		Order:              order,
+		OfferID:            int64(trade.OfferId),
+		SellerAddress:      trade.SellerId.Address(),
+		BuyerAddress:       buyer.Address(),
+		SoldAmount:         trade.AmountSold,
+		BoughtAmount:       trade.AmountBought,
+	}
+
+	err := trade.AssetSold.Extract(&t.SoldAssetType, &t.SoldAssetCode, &t.SoldAssetIssuer)
+	if err != nil {
+		return errors.Wrap(err, "failed to extract sold asset attributes")
+	}
+
+	err = trade.AssetBought.Extract(&t.BoughtAssetType, &t.BoughtAssetCode, &t.BoughtAssetIssuer)
+	if err != nil {
+		return errors.Wrap(err, "failed to extract bought asset attributes")
+	}
+
+	d.Trades = append(d.Trades, t)
+	return nil
+}
+
+// sameDetails returns whether two effects' details hold the same JSON
+// document.  The details of stored effects are formatted by postgres, and so
+// the two are compared once decoded.
+func sameDetails(a, b null.String) bool {
+	if !a.Valid || !b.Valid {
+		return a.Valid == b.Valid
+	}
+
+	var x, y interface{}
+	if decodeDetails(a.String, &x) != nil || decodeDetails(b.String, &y) != nil {
+		return false
+	}
+	return reflect.DeepEqual(x, y)
+}
+
+func decodeDetails(details string, dest *interface{}) error {
+	dec := json.NewDecoder(strings.NewReader(details))
+	dec.UseNumber()
+	return dec.Decode(dest)
+}
@@ -0,0 +1,98 @@
+package ingest
+
+import (
+	"testing"
+
+	"github.com/guregu/null"
+	"github.com/stellar/horizon/ledger"
+	"github.com/stellar/horizon/test"
+	"github.com/stellar/horizon/toid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestVerifyRange(t *testing.T) {
+	tt := test.Start(t).ScenarioWithoutHorizon("kahuna")
+	defer tt.Finish()
+
+	s := sys(tt)
+	tt.Require.NoError(s.Tick().Err)
+	tt.UpdateLedgerState()
+	ls := ledger.CurrentState()
+
+	// freshly ingested history matches stellar-core
+	report, err := s.VerifyRange(ls.HistoryElder, ls.HistoryLatest)
+	tt.Require.NoError(err)
+	tt.Assert.Equal(int(ls.HistoryLatest-ls.HistoryElder+1), report.Verified)
+	tt.Assert.Empty(report.Mismatches)
+
+	// corrupt a ledger, an effect and a trade
+	hdb := tt.HorizonSession()
+	var tradeOp, effectOp int64
+	tt.Require.NoError(hdb.GetRaw(&tradeOp, "SELECT MIN(history_operation_id) FROM history_trades"))
+	tt.Require.NoError(hdb.GetRaw(&effectOp, "SELECT MIN(history_operation_id) FROM history_effects"))
+
+	tradeLedger := toid.Parse(tradeOp).LedgerSequence
+	effectLedger := toid.Parse(effectOp).LedgerSequence
+	badLedger := ls.HistoryLatest
+
+	_, err = hdb.ExecRaw("UPDATE history_ledgers SET transaction_count = transaction_count + 1 WHERE sequence = ?", badLedger)
+	tt.Require.NoError(err)
+	_, err = hdb.ExecRaw(`DELETE FROM history_effects WHERE history_operation_id = ? AND "order" = 1`, effectOp)
+	tt.Require.NoError(err)
+	_, err = hdb.ExecRaw(`UPDATE history_trades SET sold_amount = sold_amount + 1 WHERE history_operation_id = ? AND "order" = 0`, tradeOp)
+	tt.Require.NoError(err)
+
+	var accounts int
+	tt.Require.NoError(hdb.GetRaw(&accounts, "SELECT COUNT(*) FROM history_accounts"))
+
+	// verifying does not wait for the ingestion lock
+	locker := tt.HorizonSession().Clone()
+	tt.Require.NoError(locker.Begin())
+	_, err = locker.ExecRaw("SELECT pg_advisory_xact_lock(?)", IngestionLockID)
+	tt.Require.NoError(err)
+
+	report, err = s.VerifyRange(ls.HistoryElder, ls.HistoryLatest)
+	tt.Require.NoError(err)
+	tt.Require.NoError(locker.Rollback())
+
+	tables := report.Tables()
+	tt.Assert.Equal(1, tables["history_ledgers"])
+	tt.Assert.Equal(1, tables["history_effects"])
+	tt.Assert.Equal(1, tables["history_trades"])
+	tt.Assert.Equal(0, tables["history_transactions"])
+
+	bad := map[int32]bool{}
+	for _, seq := range report.Ledgers() {
+		bad[seq] = true
+	}
+	tt.Assert.True(bad[badLedger])
+	tt.Assert.True(bad[effectLedger])
+	tt.Assert.True(bad[tradeLedger])
+
+	// verifying does not change the stored history
+	var effects int
+	tt.Require.NoError(hdb.GetRaw(&effects, `SELECT COUNT(*) FROM history_effects WHERE history_operation_id = ? AND "order" = 1`, effectOp))
+	tt.Assert.Equal(0, effects)
+
+	var after int
+	tt.Require.NoError(hdb.GetRaw(&after, "SELECT COUNT(*) FROM history_accounts"))
+	tt.Assert.Equal(accounts, after)
+
+	// reingesting the reported ledgers repairs them
+	for _, seq := range report.Ledgers() {
+		tt.Require.NoError(s.ReingestSingle(seq))
+	}
+
+	report, err = s.VerifyRange(ls.HistoryElder, ls.HistoryLatest)
+	tt.Require.NoError(err)
+	tt.Assert.Empty(report.Mismatches)
+}
+
+func TestSameDetails(t *testing.T) {
+	stored := null.StringFrom(`{"amount": "10.0000000", "offer_id": 12345678901234567}`)
+
+	assert.True(t, sameDetails(stored, null.StringFrom(`{"offer_id":12345678901234567,"amount":"10.0000000"}`)))
+	assert.False(t, sameDetails(stored, null.StringFrom(`{"offer_id":12345678901234568,"amount":"10.0000000"}`)))
+	assert.False(t, sameDetails(stored, null.String{}))
+	assert.True(t, sameDetails(null.String{}, null.String{}))
+}
@@ -3,6 +3,7 @@ package horizon
 import (
 	"log"
 
+	"github.com/stellar/horizon/bus"
 	"github.com/stellar/horizon/ingest"
 )
 
@@ -23,6 +24,18 @@ func initIngester(app *App) {
 	)
 
 	app.ingester.SkipCursorUpdate = app.config.SkipCursorUpdate
+	app.ingester.Outbox = app.config.IngestOutbox
+	app.ingester.RepairWindow = int32(app.config.IngestRepairWindow)
+
+	// several horizon processes may ingest into the same database; only the
+	// elected leader does at a time.
+	app.ingester.Election = ingest.NewElection(app.HorizonSession(nil))
+
+	// streaming requests are woken by the ledgers this process ingests, which
+	// it only does while it is the elected leader.
+	bus.EnableWhen(func() bool {
+		return app.ingester.Role() == ingest.RoleLeader
+	})
 }
 
 func init() {
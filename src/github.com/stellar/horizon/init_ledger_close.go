@@ -0,0 +1,55 @@
+package horizon
+
+import (
+	"time"
+
+	"github.com/lib/pq"
+	"github.com/stellar/horizon/db2/core"
+	"github.com/stellar/horizon/log"
+)
+
+// ledgerCloseHeartbeat is the interval at which the app still ticks when it is
+// notified of ledger closes.  The heartbeat covers missed notifications, for
+// example while the listener is reconnecting.
+const ledgerCloseHeartbeat = 10 * time.Second
+
+func initLedgerClose(app *App) {
+	if !app.config.LedgerCloseNotify {
+		return
+	}
+
+	q := &core.Q{app.CoreQ().Clone()}
+	err := q.InstallLedgerCloseTrigger()
+	if err != nil {
+		log.WithField("err", err.Error()).
+			Warn("failed to install ledger close trigger, falling back to polling")
+		return
+	}
+
+	listener := pq.NewListener(
+		app.config.StellarCoreDatabaseURL,
+		time.Second,
+		time.Minute,
+		func(ev pq.ListenerEventType, err error) {
+			if err != nil {
+				log.WithField("err", err.Error()).Warn("ledger close listener")
+			}
+		},
+	)
+
+	err = listener.Listen(core.LedgerCloseChannel)
+	if err != nil {
+		listener.Close()
+		log.WithField("err", err.Error()).
+			Warn("failed to listen for ledger closes, falling back to polling")
+		return
+	}
+
+	app.ledgerCloses = listener
+	app.ticks.Stop()
+	app.ticks = time.NewTicker(ledgerCloseHeartbeat)
+}
+
+func init() {
+	appInit.Add("ledger-close", initLedgerClose, "app-context", "log", "core-db")
+}
@@ -37,6 +37,14 @@ func initIngesterMetrics(app *App) {
 		app.ingester.Metrics.IngestLedgerTimer)
 	app.metrics.Register("ingester.clear_ledger",
 		app.ingester.Metrics.ClearLedgerTimer)
+	app.metrics.Register("ingester.leader",
+		app.ingester.Metrics.Leader)
+	app.metrics.Register("ingester.ledger_chain",
+		app.ingester.Metrics.LedgerChain)
+	app.metrics.Register("ingester.ledger_chain.repaired",
+		app.ingester.Metrics.LedgerChainRepairs)
+	app.metrics.Register("ingester.ledger_chain.repair_failed",
+		app.ingester.Metrics.LedgerChainRepairFailures)
 }
 
 func initLogMetrics(app *App) {
@@ -53,6 +61,15 @@ func initTxSubMetrics(app *App) {
 	app.metrics.Register("txsub.succeeded", app.submitter.Metrics.SuccessfulSubmissionsMeter)
 	app.metrics.Register("txsub.failed", app.submitter.Metrics.FailedSubmissionsMeter)
 	app.metrics.Register("txsub.total", app.submitter.Metrics.SubmissionTimer)
+
+	for class, gauge := range app.submitter.Metrics.BufferedSubmissionsByClass {
+		app.metrics.Register(fmt.Sprintf("txsub.buffered.%s", class), gauge)
+	}
+
+	for target, m := range app.submitter.Metrics.Targets {
+		app.metrics.Register(fmt.Sprintf("txsub.targets.%s.total", target), m.SubmissionTimer)
+		app.metrics.Register(fmt.Sprintf("txsub.targets.%s.errors", target), m.ErrorsMeter)
+	}
 }
 
 // initWebMetrics registers the metrics for the web server into the provided
@@ -1,11 +1,28 @@
 package horizon
 
 import (
+	"github.com/stellar/horizon/db2/core"
+	"github.com/stellar/horizon/log"
+	"github.com/stellar/horizon/memorypath"
 	"github.com/stellar/horizon/simplepath"
 )
 
 func initPathFinding(app *App) {
-	app.paths = &simplepath.Finder{app.CoreQ()}
+	if app.config.PathFinder != "memory" {
+		app.paths = &simplepath.Finder{app.CoreQ()}
+		return
+	}
+
+	graph := &memorypath.Graph{Q: &core.Q{app.CoreQ().Clone()}}
+
+	// a failed load is retried by the graph's next tick
+	err := graph.Load()
+	if err != nil {
+		log.WithField("err", err.Error()).Warn("failed to load path finding graph")
+	}
+
+	app.pathGraph = graph
+	app.paths = &memorypath.Finder{Graph: graph}
 }
 
 func init() {
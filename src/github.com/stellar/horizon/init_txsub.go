@@ -6,6 +6,7 @@ import (
 	"github.com/stellar/horizon/db2/core"
 	"github.com/stellar/horizon/db2/history"
 	"github.com/stellar/horizon/txsub"
+	"github.com/stellar/horizon/txsub/preflight"
 	results "github.com/stellar/horizon/txsub/results/db"
 	"github.com/stellar/horizon/txsub/sequence"
 )
@@ -13,19 +14,41 @@ import (
 func initSubmissionSystem(app *App) {
 	cq := &core.Q{Session: app.CoreSession(nil)}
 
+	pending := txsub.NewDefaultSubmissionList()
+	if app.redis != nil {
+		pending = txsub.NewRedisSubmissionList(app.redis)
+	}
+
+	submitter := txsub.NewDefaultSubmitter(http.DefaultClient, app.config.StellarCoreURL)
+	if len(app.config.StellarCoreSubmitURLs) > 0 {
+		submitter = txsub.NewMultiSubmitter(http.DefaultClient, app.config.StellarCoreSubmitURLs)
+	}
+
+	queue := sequence.NewManager()
+	if app.config.MaxSubmissionQueueSize > 0 {
+		queue.MaxSize = app.config.MaxSubmissionQueueSize
+	}
+	if app.config.MaxAccountSubmissionQueueSize > 0 {
+		queue.MaxAccountSize = app.config.MaxAccountSubmissionQueueSize
+	}
+
 	app.submitter = &txsub.System{
-		Pending:         txsub.NewDefaultSubmissionList(),
-		Submitter:       txsub.NewDefaultSubmitter(http.DefaultClient, app.config.StellarCoreURL),
-		SubmissionQueue: sequence.NewManager(),
+		Pending:         pending,
+		Submitter:       submitter,
+		SubmissionQueue: queue,
 		Results: &results.DB{
 			Core:    cq,
 			History: &history.Q{Session: app.HorizonSession(nil)},
 		},
-		Sequences:         cq.SequenceProvider(),
+		Sequences: cq.SequenceProvider(),
+		Validator: &preflight.Validator{
+			Core:              cq,
+			NetworkPassphrase: app.networkPassphrase,
+		},
 		NetworkPassphrase: app.networkPassphrase,
 	}
 }
 
 func init() {
-	appInit.Add("txsub", initSubmissionSystem, "app-context", "log", "horizon-db", "core-db")
+	appInit.Add("txsub", initSubmissionSystem, "app-context", "log", "horizon-db", "core-db", "redis")
 }
@@ -39,6 +39,7 @@ func initWeb(app *App) {
 	// register problems
 	problem.RegisterError(sql.ErrNoRows, problem.NotFound)
 	problem.RegisterError(sequence.ErrNoMoreRoom, problem.ServerOverCapacity)
+	problem.RegisterError(sequence.ErrAccountQueueFull, problem.AccountOverCapacity)
 }
 
 // initWebMiddleware installs the middleware stack used for horizon onto the
@@ -94,6 +95,7 @@ func initWebActions(app *App) {
 	// transaction history actions
 	r.Get("/transactions", &TransactionIndexAction{})
 	r.Get("/transactions/:id", &TransactionShowAction{})
+	r.Get("/transactions/:id/status", &TransactionStatusAction{})
 	r.Get("/transactions/:tx_id/operations", &OperationIndexAction{})
 	r.Get("/transactions/:tx_id/payments", &PaymentsIndexAction{})
 	r.Get("/transactions/:tx_id/effects", &EffectIndexAction{})
@@ -108,14 +110,22 @@ func initWebActions(app *App) {
 
 	// trading related endpoints
 	r.Get("/trades", &TradeIndexAction{})
-	r.Get("/offers/:id", &NotImplementedAction{})
+	r.Get("/trade_aggregations", &TradeAggregateIndexAction{})
+	r.Get("/assets", &AssetsAction{})
+	r.Get("/offers/:id", &OfferShowAction{})
 	r.Get("/offers/:offer_id/trades", &TradeIndexAction{})
 	r.Get("/order_book", &OrderBookShowAction{})
 	r.Get("/order_book/trades", &OrderBookTradeIndexAction{})
+	r.Get("/subscriptions", &SubscriptionsAction{})
+	r.Post("/subscriptions", &SubscriptionsAction{})
 
 	// Transaction submission API
 	r.Post("/transactions", &TransactionCreateAction{})
+	r.Post("/transactions_async", &TransactionCreateAction{Async: true})
+	r.Post("/transactions/validate", &TransactionValidateAction{})
+	r.Post("/transactions/batch", &TransactionBatchAction{})
 	r.Get("/paths", &PathIndexAction{})
+	r.Get("/paths/strict-send", &PathStrictSendAction{})
 
 	// friendbot
 	r.Post("/friendbot", &FriendbotAction{})
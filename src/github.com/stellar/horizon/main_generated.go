@@ -12,6 +12,13 @@ func (action AccountShowAction) ServeHTTPC(c web.C, w http.ResponseWriter, r *ht
 	ap.Execute(&action)
 }
 
+// ServeHTTPC is a method for web.Handler
+func (action AssetsAction) ServeHTTPC(c web.C, w http.ResponseWriter, r *http.Request) {
+	ap := &action.Action
+	ap.Prepare(c, w, r)
+	ap.Execute(&action)
+}
+
 // ServeHTTPC is a method for web.Handler
 func (action DataShowAction) ServeHTTPC(c web.C, w http.ResponseWriter, r *http.Request) {
 	ap := &action.Action
@@ -61,6 +68,13 @@ func (action NotImplementedAction) ServeHTTPC(c web.C, w http.ResponseWriter, r
 	ap.Execute(&action)
 }
 
+// ServeHTTPC is a method for web.Handler
+func (action OfferShowAction) ServeHTTPC(c web.C, w http.ResponseWriter, r *http.Request) {
+	ap := &action.Action
+	ap.Prepare(c, w, r)
+	ap.Execute(&action)
+}
+
 // ServeHTTPC is a method for web.Handler
 func (action OffersByAccountAction) ServeHTTPC(c web.C, w http.ResponseWriter, r *http.Request) {
 	ap := &action.Action
@@ -103,6 +117,13 @@ func (action PathIndexAction) ServeHTTPC(c web.C, w http.ResponseWriter, r *http
 	ap.Execute(&action)
 }
 
+// ServeHTTPC is a method for web.Handler
+func (action PathStrictSendAction) ServeHTTPC(c web.C, w http.ResponseWriter, r *http.Request) {
+	ap := &action.Action
+	ap.Prepare(c, w, r)
+	ap.Execute(&action)
+}
+
 // ServeHTTPC is a method for web.Handler
 func (action PaymentsIndexAction) ServeHTTPC(c web.C, w http.ResponseWriter, r *http.Request) {
 	ap := &action.Action
@@ -124,6 +145,20 @@ func (action RootAction) ServeHTTPC(c web.C, w http.ResponseWriter, r *http.Requ
 	ap.Execute(&action)
 }
 
+// ServeHTTPC is a method for web.Handler
+func (action SubscriptionsAction) ServeHTTPC(c web.C, w http.ResponseWriter, r *http.Request) {
+	ap := &action.Action
+	ap.Prepare(c, w, r)
+	ap.Execute(&action)
+}
+
+// ServeHTTPC is a method for web.Handler
+func (action TradeAggregateIndexAction) ServeHTTPC(c web.C, w http.ResponseWriter, r *http.Request) {
+	ap := &action.Action
+	ap.Prepare(c, w, r)
+	ap.Execute(&action)
+}
+
 // ServeHTTPC is a method for web.Handler
 func (action TradeEffectIndexAction) ServeHTTPC(c web.C, w http.ResponseWriter, r *http.Request) {
 	ap := &action.Action
@@ -138,6 +173,13 @@ func (action TradeIndexAction) ServeHTTPC(c web.C, w http.ResponseWriter, r *htt
 	ap.Execute(&action)
 }
 
+// ServeHTTPC is a method for web.Handler
+func (action TransactionBatchAction) ServeHTTPC(c web.C, w http.ResponseWriter, r *http.Request) {
+	ap := &action.Action
+	ap.Prepare(c, w, r)
+	ap.Execute(&action)
+}
+
 // ServeHTTPC is a method for web.Handler
 func (action TransactionCreateAction) ServeHTTPC(c web.C, w http.ResponseWriter, r *http.Request) {
 	ap := &action.Action
@@ -158,3 +200,17 @@ func (action TransactionShowAction) ServeHTTPC(c web.C, w http.ResponseWriter, r
 	ap.Prepare(c, w, r)
 	ap.Execute(&action)
 }
+
+// ServeHTTPC is a method for web.Handler
+func (action TransactionStatusAction) ServeHTTPC(c web.C, w http.ResponseWriter, r *http.Request) {
+	ap := &action.Action
+	ap.Prepare(c, w, r)
+	ap.Execute(&action)
+}
+
+// ServeHTTPC is a method for web.Handler
+func (action TransactionValidateAction) ServeHTTPC(c web.C, w http.ResponseWriter, r *http.Request) {
+	ap := &action.Action
+	ap.Prepare(c, w, r)
+	ap.Execute(&action)
+}
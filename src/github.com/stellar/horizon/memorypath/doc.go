@@ -0,0 +1,4 @@
+// Package memorypath provides an implementation of paths.Finder that performs
+// a breadth first search for paths against an in-memory graph of the offers in
+// a stellar-core's database, which is refreshed as each ledger closes.
+package memorypath
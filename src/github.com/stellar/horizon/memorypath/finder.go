@@ -0,0 +1,30 @@
+package memorypath
+
+import (
+	"github.com/stellar/horizon/paths"
+)
+
+// Finder implements the paths.Finder interface and searches for payment paths
+// using a breadth first search of an in-memory graph of offers.  Unlike
+// simplepath.Finder, a search does not query the database.
+//
+// The graph is locked for each order book a search reads rather than for the
+// whole search, so that a search does not delay the ledgers being applied to
+// the graph.  Every path found is priced again against the latest graph when
+// the results are sorted.
+type Finder struct {
+	Graph *Graph
+}
+
+// ensure the struct is paths.Finder compliant
+var _ paths.Finder = &Finder{}
+
+// Find performs a path find with the provided query.
+func (f *Finder) Find(q paths.Query) ([]paths.Path, error) {
+	return paths.Find(q, f.Graph)
+}
+
+// FindStrictSend performs a strict-send path find with the provided query.
+func (f *Finder) FindStrictSend(q paths.Query) ([]paths.Path, error) {
+	return paths.FindStrictSend(q, f.Graph)
+}
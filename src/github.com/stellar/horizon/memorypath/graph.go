@@ -0,0 +1,484 @@
+package memorypath
+
+import (
+	"math/big"
+	"sort"
+	"sync"
+
+	"github.com/stellar/go/support/errors"
+	"github.com/stellar/go/xdr"
+	"github.com/stellar/horizon/assets"
+	"github.com/stellar/horizon/db2/core"
+	"github.com/stellar/horizon/log"
+	"github.com/stellar/horizon/paths"
+)
+
+// MaxCatchup is the largest number of ledgers a Graph applies incrementally.
+// A graph that has fallen further behind stellar-core is reloaded instead.
+const MaxCatchup = 100
+
+// Graph is an in-memory copy of the offers in a stellar-core database,
+// organized into order books.  It is loaded in full once and then kept up to
+// date by applying the offer changes recorded in the meta of each newly closed
+// ledger's transactions.  A Graph is safe for concurrent use, and implements
+// the paths.OrderBooks interface.
+type Graph struct {
+	Q *core.Q
+
+	lock   sync.RWMutex
+	ledger int32
+	offers map[int64]offer
+	// books maps a selling asset to the order books selling it, keyed by the
+	// asset being bought.
+	books map[string]map[string]*orderBook
+	// sellers maps a buying asset to the same order books, keyed by the asset
+	// being sold.
+	sellers map[string]map[string]*orderBook
+	assets  map[string]xdr.Asset
+}
+
+// offer is the part of an offer that matters to path finding.
+type offer struct {
+	ID      int64
+	Selling string
+	Buying  string
+	Amount  int64
+	Pricen  int64
+	Priced  int64
+	Price   float64
+}
+
+// orderBook holds the offers selling one asset for another, ordered by price.
+type orderBook struct {
+	Offers []offer
+}
+
+// Ledger returns the sequence of the last ledger applied to the graph, or 0
+// if the graph has not yet been loaded.
+func (g *Graph) Ledger() int32 {
+	g.lock.RLock()
+	defer g.lock.RUnlock()
+	return g.ledger
+}
+
+// Tick brings the graph up to date with stellar-core, logging any failure.
+func (g *Graph) Tick() {
+	err := g.Update()
+	if err != nil {
+		log.WithField("err", err.Error()).Error("failed to update path finding graph")
+	}
+}
+
+// Update brings the graph up to date with the latest ledger in stellar-core.
+func (g *Graph) Update() error {
+	var latest, elder int32
+
+	err := g.Q.LatestLedger(&latest)
+	if err != nil {
+		return errors.Wrap(err, "load latest ledger failed")
+	}
+
+	err = g.Q.ElderLedger(&elder)
+	if err != nil {
+		return errors.Wrap(err, "load elder ledger failed")
+	}
+
+	current := g.Ledger()
+
+	switch {
+	case current == latest:
+		return nil
+	case current == 0, current < elder, latest-current > MaxCatchup:
+		return g.Load()
+	}
+
+	var changes []xdr.LedgerEntryChange
+	for seq := current + 1; seq <= latest; seq++ {
+		var txs []core.Transaction
+		err = g.Q.TransactionsByLedger(&txs, seq)
+		if err != nil {
+			return errors.Wrap(err, "load transactions failed")
+		}
+
+		for _, tx := range txs {
+			for _, op := range tx.ResultMeta.MustOperations() {
+				changes = append(changes, op.Changes...)
+			}
+		}
+	}
+
+	g.lock.Lock()
+	defer g.lock.Unlock()
+
+	// another update may have won the race
+	if g.ledger != current {
+		return nil
+	}
+
+	for _, change := range changes {
+		g.applyChange(change)
+	}
+	g.ledger = latest
+
+	return nil
+}
+
+// Load replaces the contents of the graph with every offer currently in
+// stellar-core.
+func (g *Graph) Load() error {
+	var latest int32
+
+	// The ledger is read before the offers, so that any ledger closed while
+	// the offers are loading is applied again by the next update.  Replaying
+	// those changes is harmless, as each change carries the entry's full state.
+	err := g.Q.LatestLedger(&latest)
+	if err != nil {
+		return errors.Wrap(err, "load latest ledger failed")
+	}
+
+	var rows []core.Offer
+	err = g.Q.AllOffers(&rows)
+	if err != nil {
+		return errors.Wrap(err, "load offers failed")
+	}
+
+	g.lock.Lock()
+	defer g.lock.Unlock()
+
+	g.reset()
+	for _, row := range rows {
+		selling, err := core.AssetFromDB(
+			row.SellingAssetType,
+			row.SellingAssetCode.String,
+			row.SellingIssuer.String,
+		)
+		if err != nil {
+			return errors.Wrap(err, "invalid selling asset")
+		}
+
+		buying, err := core.AssetFromDB(
+			row.BuyingAssetType,
+			row.BuyingAssetCode.String,
+			row.BuyingIssuer.String,
+		)
+		if err != nil {
+			return errors.Wrap(err, "invalid buying asset")
+		}
+
+		g.addOffer(row.OfferID, selling, buying, int64(row.Amount), int64(row.Pricen), int64(row.Priced))
+	}
+	g.ledger = latest
+
+	log.WithField("offers", len(rows)).
+		WithField("ledger", latest).
+		Info("loaded path finding graph")
+
+	return nil
+}
+
+// check interface compatibility
+var _ paths.OrderBooks = &Graph{}
+
+// ConnectedAssets returns the assets bought by the offers selling `selling`.
+func (g *Graph) ConnectedAssets(selling xdr.Asset) ([]xdr.Asset, error) {
+	g.lock.RLock()
+	defer g.lock.RUnlock()
+	return g.connectedAssets(selling), nil
+}
+
+// Cost returns the amount of `buying` needed to buy `amount` of `selling` in
+// the order book of offers selling `selling` for `buying`.
+func (g *Graph) Cost(
+	selling xdr.Asset,
+	buying xdr.Asset,
+	amount xdr.Int64,
+) (xdr.Int64, error) {
+	g.lock.RLock()
+	defer g.lock.RUnlock()
+	return g.cost(selling, buying, selling, amount)
+}
+
+// ConnectedSellingAssets returns the assets sold by the offers buying
+// `buying`.
+func (g *Graph) ConnectedSellingAssets(buying xdr.Asset) ([]xdr.Asset, error) {
+	g.lock.RLock()
+	defer g.lock.RUnlock()
+	return g.connectedSellingAssets(buying), nil
+}
+
+// Receive returns the amount of `selling` received in exchange for `amount` of
+// `buying` from the order book of offers selling `selling` for `buying`.
+func (g *Graph) Receive(
+	selling xdr.Asset,
+	buying xdr.Asset,
+	amount xdr.Int64,
+) (xdr.Int64, error) {
+	g.lock.RLock()
+	defer g.lock.RUnlock()
+	return g.receive(selling, buying, amount)
+}
+
+// BestPrice returns the price, delimited in `buying` per `selling`, of the best
+// offer selling `selling` for `buying`.
+func (g *Graph) BestPrice(selling, buying xdr.Asset) (*big.Rat, error) {
+	g.lock.RLock()
+	defer g.lock.RUnlock()
+	return g.bestPrice(selling, buying)
+}
+
+func (g *Graph) connectedAssets(selling xdr.Asset) []xdr.Asset {
+	return g.sortedAssets(g.books[selling.String()])
+}
+
+func (g *Graph) connectedSellingAssets(buying xdr.Asset) []xdr.Asset {
+	return g.sortedAssets(g.sellers[buying.String()])
+}
+
+// sortedAssets returns the assets identified by the keys of `books`, in a
+// stable order.
+func (g *Graph) sortedAssets(books map[string]*orderBook) []xdr.Asset {
+	keys := make([]string, 0, len(books))
+	for key := range books {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	result := make([]xdr.Asset, len(keys))
+	for i, key := range keys {
+		result[i] = g.assets[key]
+	}
+
+	return result
+}
+
+// cost returns the amount of `source` needed to buy `amount` of the other
+// asset in the order book of offers selling `selling` for `buying`.  `source`
+// must be either the selling or buying asset.
+func (g *Graph) cost(
+	selling xdr.Asset,
+	buying xdr.Asset,
+	source xdr.Asset,
+	amount xdr.Int64,
+) (xdr.Int64, error) {
+	book := g.books[selling.String()][buying.String()]
+	if book == nil {
+		return 0, paths.ErrNotEnough
+	}
+
+	var (
+		needed   = int64(amount)
+		cost     int64
+		inverted = assets.Equals(source, buying)
+		n        = len(book.Offers)
+	)
+
+	for i := 0; i < n; i++ {
+		var available, pricen, priced int64
+
+		if inverted {
+			o := book.Offers[n-1-i]
+			pricen, priced = o.Priced, o.Pricen
+			available = mul(o.Amount, pricen, priced)
+		} else {
+			o := book.Offers[i]
+			pricen, priced = o.Pricen, o.Priced
+			available = o.Amount
+		}
+
+		if available >= needed {
+			cost += mul(needed, pricen, priced)
+			return xdr.Int64(cost), nil
+		}
+
+		cost += mul(available, pricen, priced)
+		needed -= available
+	}
+
+	return 0, paths.ErrNotEnough
+}
+
+func (g *Graph) bestPrice(selling, buying xdr.Asset) (*big.Rat, error) {
+	book := g.books[selling.String()][buying.String()]
+	if book == nil {
+		return nil, paths.ErrNotEnough
+	}
+
+	o := book.Offers[0]
+	return big.NewRat(o.Pricen, o.Priced), nil
+}
+
+func (g *Graph) receive(
+	selling xdr.Asset,
+	buying xdr.Asset,
+	amount xdr.Int64,
+) (xdr.Int64, error) {
+	book := g.books[selling.String()][buying.String()]
+	if book == nil {
+		return 0, paths.ErrNotEnough
+	}
+
+	var (
+		remaining = int64(amount)
+		received  int64
+	)
+
+	for _, o := range book.Offers {
+		capacity := mul(o.Amount, o.Pricen, o.Priced)
+		if capacity >= remaining {
+			received += mul(remaining, o.Priced, o.Pricen)
+			return xdr.Int64(received), nil
+		}
+
+		received += o.Amount
+		remaining -= capacity
+	}
+
+	return 0, paths.ErrNotEnough
+}
+
+// applyChange applies a single ledger entry change from a transaction's meta
+// to the graph.  Changes to entries other than offers are ignored.
+func (g *Graph) applyChange(change xdr.LedgerEntryChange) {
+	var entry xdr.LedgerEntry
+
+	switch change.Type {
+	case xdr.LedgerEntryChangeTypeLedgerEntryCreated:
+		entry = change.MustCreated()
+	case xdr.LedgerEntryChangeTypeLedgerEntryUpdated:
+		entry = change.MustUpdated()
+	case xdr.LedgerEntryChangeTypeLedgerEntryRemoved:
+		key, ok := change.MustRemoved().GetOffer()
+		if ok {
+			g.removeOffer(int64(key.OfferId))
+		}
+		return
+	default:
+		return
+	}
+
+	o, ok := entry.Data.GetOffer()
+	if !ok {
+		return
+	}
+
+	g.addOffer(
+		int64(o.OfferId),
+		o.Selling,
+		o.Buying,
+		int64(o.Amount),
+		int64(o.Price.N),
+		int64(o.Price.D),
+	)
+}
+
+// addOffer adds an offer to the graph, replacing any offer with the same id.
+func (g *Graph) addOffer(id int64, selling, buying xdr.Asset, amount, pricen, priced int64) {
+	g.removeOffer(id)
+
+	o := offer{
+		ID:      id,
+		Selling: selling.String(),
+		Buying:  buying.String(),
+		Amount:  amount,
+		Pricen:  pricen,
+		Priced:  priced,
+		Price:   float64(pricen) / float64(priced),
+	}
+
+	g.assets[o.Selling] = selling
+	g.assets[o.Buying] = buying
+
+	books, ok := g.books[o.Selling]
+	if !ok {
+		books = map[string]*orderBook{}
+		g.books[o.Selling] = books
+	}
+
+	book, ok := books[o.Buying]
+	if !ok {
+		book = &orderBook{}
+		books[o.Buying] = book
+
+		sellers, ok := g.sellers[o.Buying]
+		if !ok {
+			sellers = map[string]*orderBook{}
+			g.sellers[o.Buying] = sellers
+		}
+		sellers[o.Selling] = book
+	}
+
+	i := sort.Search(len(book.Offers), func(i int) bool {
+		return book.Offers[i].after(o)
+	})
+	book.Offers = append(book.Offers, offer{})
+	copy(book.Offers[i+1:], book.Offers[i:])
+	book.Offers[i] = o
+
+	g.offers[id] = o
+}
+
+// removeOffer removes the offer identified by `id` from the graph, if present.
+func (g *Graph) removeOffer(id int64) {
+	o, ok := g.offers[id]
+	if !ok {
+		return
+	}
+
+	delete(g.offers, id)
+
+	books := g.books[o.Selling]
+	book := books[o.Buying]
+	for i := range book.Offers {
+		if book.Offers[i].ID == id {
+			book.Offers = append(book.Offers[:i], book.Offers[i+1:]...)
+			break
+		}
+	}
+
+	if len(book.Offers) > 0 {
+		return
+	}
+
+	delete(books, o.Buying)
+	if len(books) == 0 {
+		delete(g.books, o.Selling)
+	}
+
+	sellers := g.sellers[o.Buying]
+	delete(sellers, o.Selling)
+	if len(sellers) == 0 {
+		delete(g.sellers, o.Buying)
+	}
+}
+
+// reset empties the graph.
+func (g *Graph) reset() {
+	g.ledger = 0
+	g.offers = map[int64]offer{}
+	g.books = map[string]map[string]*orderBook{}
+	g.sellers = map[string]map[string]*orderBook{}
+	g.assets = map[string]xdr.Asset{}
+}
+
+// after returns true if `o` is ordered after `other` within an order book:
+// by price, and then by id.
+func (o offer) after(other offer) bool {
+	if o.Price != other.Price {
+		return o.Price > other.Price
+	}
+
+	return o.ID > other.ID
+}
+
+// mul multiplies the input amount by the input price
+func mul(amount int64, pricen int64, priced int64) int64 {
+	var r, n, d big.Int
+
+	r.SetInt64(amount)
+	n.SetInt64(pricen)
+	d.SetInt64(priced)
+
+	r.Mul(&r, &n)
+	r.Quo(&r, &d)
+	return r.Int64()
+}
@@ -0,0 +1,132 @@
+package memorypath
+
+import (
+	"testing"
+
+	"github.com/stellar/go/xdr"
+	"github.com/stellar/horizon/db2/core"
+	"github.com/stellar/horizon/paths"
+	"github.com/stellar/horizon/test"
+)
+
+func TestGraph_Cost(t *testing.T) {
+	tt := test.Start(t).Scenario("paths")
+	defer tt.Finish()
+
+	graph := &Graph{Q: &core.Q{Session: tt.CoreSession()}}
+	tt.Require.NoError(graph.Load())
+
+	eur := test.MakeAsset(
+		xdr.AssetTypeAssetTypeCreditAlphanum4,
+		"EUR",
+		"GDSBCQO34HWPGUGQSP3QBFEXVTSR2PW46UIGTHVWGWJGQKH3AFNHXHXN")
+	usd := test.MakeAsset(
+		xdr.AssetTypeAssetTypeCreditAlphanum4,
+		"USD",
+		"GDSBCQO34HWPGUGQSP3QBFEXVTSR2PW46UIGTHVWGWJGQKH3AFNHXHXN")
+
+	// the same costs as simplepath's TestOrderBook
+	expectations := map[xdr.Int64]xdr.Int64{
+		10000000:  10000000,
+		100000000: 100000000,
+		100000001: 100000002,
+		500000000: 900000000,
+	}
+
+	for amount, expected := range expectations {
+		r, err := graph.cost(eur, usd, usd, amount)
+		if tt.Assert.NoError(err) {
+			tt.Assert.Equal(expected, r, "amount: %d", amount)
+		}
+	}
+
+	_, err := graph.cost(eur, usd, usd, 500000001)
+	tt.Assert.Equal(paths.ErrNotEnough, err)
+}
+
+func TestGraph_ApplyChange(t *testing.T) {
+	tt := test.Start(t)
+	defer tt.Finish()
+
+	native := test.MakeAsset(xdr.AssetTypeAssetTypeNative, "", "")
+	usd := test.MakeAsset(
+		xdr.AssetTypeAssetTypeCreditAlphanum4,
+		"USD",
+		"GDSBCQO34HWPGUGQSP3QBFEXVTSR2PW46UIGTHVWGWJGQKH3AFNHXHXN")
+
+	graph := &Graph{}
+	graph.reset()
+
+	offer := func(id xdr.Uint64, amount xdr.Int64, n, d xdr.Int32) xdr.LedgerEntry {
+		return xdr.LedgerEntry{
+			Data: xdr.LedgerEntryData{
+				Type: xdr.LedgerEntryTypeOffer,
+				Offer: &xdr.OfferEntry{
+					OfferId: id,
+					Selling: usd,
+					Buying:  native,
+					Amount:  amount,
+					Price:   xdr.Price{N: n, D: d},
+				},
+			},
+		}
+	}
+
+	created := func(e xdr.LedgerEntry) xdr.LedgerEntryChange {
+		return xdr.LedgerEntryChange{
+			Type:    xdr.LedgerEntryChangeTypeLedgerEntryCreated,
+			Created: &e,
+		}
+	}
+
+	// two offers selling USD for XLM, at 2 and 1 XLM each
+	graph.applyChange(created(offer(1, 100, 2, 1)))
+	graph.applyChange(created(offer(2, 100, 1, 1)))
+
+	tt.Assert.Equal([]xdr.Asset{native}, graph.connectedAssets(usd))
+	tt.Assert.Empty(graph.connectedAssets(native))
+
+	// the cheaper offer is taken first
+	r, err := graph.Cost(usd, native, 150)
+	if tt.Assert.NoError(err) {
+		tt.Assert.Equal(xdr.Int64(200), r)
+	}
+
+	best, err := graph.BestPrice(usd, native)
+	if tt.Assert.NoError(err) {
+		tt.Assert.Equal("1/1", best.String())
+	}
+
+	// sending XLM takes the cheaper offer first, too
+	tt.Assert.Equal([]xdr.Asset{usd}, graph.connectedSellingAssets(native))
+	r, err = graph.Receive(usd, native, 150)
+	if tt.Assert.NoError(err) {
+		tt.Assert.Equal(xdr.Int64(125), r)
+	}
+
+	// the cheaper offer is partially filled
+	updated := offer(2, 10, 1, 1)
+	graph.applyChange(xdr.LedgerEntryChange{
+		Type:    xdr.LedgerEntryChangeTypeLedgerEntryUpdated,
+		Updated: &updated,
+	})
+
+	_, err = graph.Cost(usd, native, 150)
+	tt.Assert.Equal(paths.ErrNotEnough, err)
+
+	// both offers are removed
+	for _, id := range []xdr.Uint64{1, 2} {
+		key := xdr.LedgerKey{
+			Type:  xdr.LedgerEntryTypeOffer,
+			Offer: &xdr.LedgerKeyOffer{OfferId: id},
+		}
+		graph.applyChange(xdr.LedgerEntryChange{
+			Type:    xdr.LedgerEntryChangeTypeLedgerEntryRemoved,
+			Removed: &key,
+		})
+	}
+
+	tt.Assert.Empty(graph.connectedAssets(usd))
+	tt.Assert.Empty(graph.connectedSellingAssets(native))
+	tt.Assert.Empty(graph.offers)
+}
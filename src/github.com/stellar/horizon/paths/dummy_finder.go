@@ -1,12 +1,18 @@
 package paths
 
 import (
+	"math/big"
+
 	"github.com/stellar/go/xdr"
 )
 
 type DummyFinder struct {
 }
 
+func (f *DummyFinder) FindStrictSend(q Query) ([]Path, error) {
+	return f.Find(q)
+}
+
 func (f *DummyFinder) Find(q Query) ([]Path, error) {
 	paths := make([]Path, 2)
 	n, err := xdr.NewAsset(xdr.AssetTypeAssetTypeNative, nil)
@@ -36,7 +42,9 @@ type DummyPath struct {
 	path        []xdr.Asset
 }
 
-func (d DummyPath) Source() xdr.Asset                        { return d.source }
-func (d DummyPath) Destination() xdr.Asset                   { return d.destination }
-func (d DummyPath) Path() []xdr.Asset                        { return d.path }
-func (d DummyPath) Cost(amount xdr.Int64) (xdr.Int64, error) { return amount, nil }
+func (d DummyPath) Source() xdr.Asset                           { return d.source }
+func (d DummyPath) Destination() xdr.Asset                      { return d.destination }
+func (d DummyPath) Path() []xdr.Asset                           { return d.path }
+func (d DummyPath) Cost(amount xdr.Int64) (xdr.Int64, error)    { return amount, nil }
+func (d DummyPath) Receive(amount xdr.Int64) (xdr.Int64, error) { return amount, nil }
+func (d DummyPath) BestPrice() (*big.Rat, error)                { return big.NewRat(1, 1), nil }
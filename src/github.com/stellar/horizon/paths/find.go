@@ -0,0 +1,76 @@
+package paths
+
+import (
+	"errors"
+
+	"github.com/stellar/horizon/log"
+)
+
+// Find finds the paths that deliver the query's destination amount of any of
+// its destination assets, searching `books` from each of its source assets.
+// The paths are ordered from cheapest to most expensive, and truncated to the
+// query's path limit.  Finder implementations call Find with their view of the
+// order books.
+func Find(q Query, books OrderBooks) (result []Path, err error) {
+	log.WithField("source_assets", q.SourceAssets).
+		WithField("destination_assets", q.DestinationAssets).
+		WithField("destination_amount", q.DestinationAmount).
+		Info("Starting pathfind")
+
+	if len(q.SourceAssets) == 0 {
+		err = errors.New("No source assets")
+		return
+	}
+
+	// each destination asset is searched separately, so that the paths to one
+	// do not hide the paths to another
+	for _, dest := range q.DestinationAssets {
+		var found []Path
+		found, err = Search(q, dest, books)
+		if err != nil {
+			break
+		}
+		result = append(result, found...)
+	}
+
+	if err == nil {
+		err = SortByCost(result, q.DestinationAmount)
+	}
+
+	result = truncate(result, q)
+
+	log.WithField("found", len(result)).
+		WithField("err", err).
+		Info("Finished pathfind")
+	return
+}
+
+// FindStrictSend finds the paths along which the query's source amount can be
+// sent to any of its destination assets, searching `books`.  The paths are
+// ordered from the one delivering the most to the one delivering the least,
+// and truncated to the query's path limit.
+func FindStrictSend(q Query, books OrderBooks) (result []Path, err error) {
+	log.WithField("source_asset", q.SourceAsset).
+		WithField("source_amount", q.SourceAmount).
+		WithField("destination_assets", q.DestinationAssets).
+		Info("Starting strict send pathfind")
+
+	result, err = SearchStrictSend(q, books)
+	if err == nil {
+		err = SortByReceived(result, q.SourceAmount)
+	}
+
+	result = truncate(result, q)
+
+	log.WithField("found", len(result)).
+		WithField("err", err).
+		Info("Finished strict send pathfind")
+	return
+}
+
+func truncate(ps []Path, q Query) []Path {
+	if limit := q.PathLimit(); len(ps) > limit {
+		return ps[:limit]
+	}
+	return ps
+}
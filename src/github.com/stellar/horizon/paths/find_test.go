@@ -0,0 +1,63 @@
+package paths
+
+import (
+	"testing"
+
+	"github.com/stellar/go/xdr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFind(t *testing.T) {
+	xlm, usd, eur := testAssets()
+
+	// the direct order book is deep enough, but dearer than the path through
+	// USD, which is found after it
+	books := fakeBooks{
+		{Selling: usd, Buying: xlm, Amount: 1000, Price: 2},
+		{Selling: eur, Buying: usd, Amount: 1000, Price: 3},
+		{Selling: eur, Buying: xlm, Amount: 1000, Price: 10},
+	}
+
+	q := Query{
+		SourceAssets:      []xdr.Asset{xlm},
+		DestinationAssets: []xdr.Asset{eur},
+		DestinationAmount: 10,
+	}
+
+	found, err := Find(q, books)
+	require.NoError(t, err)
+	require.Len(t, found, 2)
+	assert.Equal(t, []xdr.Asset{usd}, found[0].Path())
+	assert.Empty(t, found[1].Path())
+
+	q.MaxPaths = 1
+	found, err = Find(q, books)
+	require.NoError(t, err)
+	require.Len(t, found, 1)
+	assert.Equal(t, []xdr.Asset{usd}, found[0].Path())
+
+	q.SourceAssets = nil
+	_, err = Find(q, books)
+	assert.Error(t, err)
+}
+
+func TestFindStrictSend(t *testing.T) {
+	xlm, usd, eur := testAssets()
+
+	q := Query{
+		SourceAsset:  xlm,
+		SourceAmount: 60,
+		MaxPaths:     1,
+	}
+
+	// without destination assets both USD and EUR are reached, and USD is
+	// received in the larger amount
+	found, err := FindStrictSend(q, testBooks(xlm, usd, eur))
+	require.NoError(t, err)
+	require.Len(t, found, 1)
+	assert.Equal(t, usd, found[0].Destination())
+
+	_, err = FindStrictSend(q, failingBooks{})
+	assert.Error(t, err)
+}
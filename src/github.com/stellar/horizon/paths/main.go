@@ -1,15 +1,66 @@
 package paths
 
 import (
+	"errors"
+	"math/big"
+
 	"github.com/stellar/go/xdr"
 )
 
-// Query is a query for paths
+const (
+	// DefaultMaxPaths is the number of paths a search returns when the query
+	// does not specify MaxPaths.
+	DefaultMaxPaths = 5
+	// MaxPathsLimit is the largest number of paths a search may return.
+	MaxPathsLimit = 20
+	// MaxHopsLimit is the largest number of order books a path may cross, and
+	// the default when the query does not specify MaxHops.  A PathPaymentOp's
+	// path cannot be over 5 assets in length, which allows for 6 hops.
+	MaxHopsLimit = 6
+)
+
+// Query is a query for paths.  A strict-receive query (see Finder.Find)
+// searches from SourceAssets to DestinationAssets, such that DestinationAmount
+// of the destination asset can be delivered.  A strict-send query (see
+// Finder.FindStrictSend) searches from SourceAsset to DestinationAssets, or to
+// any asset when none are given, such that SourceAmount can be sent.
 type Query struct {
 	DestinationAddress string
-	DestinationAsset   xdr.Asset
+	DestinationAssets  []xdr.Asset
 	DestinationAmount  xdr.Int64
 	SourceAssets       []xdr.Asset
+
+	SourceAsset  xdr.Asset
+	SourceAmount xdr.Int64
+
+	// MaxPaths is the number of paths to find.  Zero selects DefaultMaxPaths.
+	MaxPaths int
+	// MaxHops is the number of order books a path may cross.  Zero selects
+	// MaxHopsLimit.
+	MaxHops int
+}
+
+// PathLimit returns the number of paths the query should find, applying the
+// default and server-side cap.
+func (q Query) PathLimit() int {
+	switch {
+	case q.MaxPaths <= 0:
+		return DefaultMaxPaths
+	case q.MaxPaths > MaxPathsLimit:
+		return MaxPathsLimit
+	default:
+		return q.MaxPaths
+	}
+}
+
+// HopLimit returns the number of order books a path found by the query may
+// cross, applying the default and server-side cap.
+func (q Query) HopLimit() int {
+	if q.MaxHops <= 0 || q.MaxHops > MaxHopsLimit {
+		return MaxHopsLimit
+	}
+
+	return q.MaxHops
 }
 
 // Path is the interface that represents a single result returned
@@ -21,9 +72,51 @@ type Path interface {
 	// Cost returns an amount (which may be estimated), delimited in the Source assets
 	// that is suitable for use as the `sendMax` field for a `PathPaymentOp` struct.
 	Cost(amount xdr.Int64) (xdr.Int64, error)
+	// Receive returns an amount (which may be estimated), delimited in the
+	// Destination asset, that sending `amount` of the Source asset along the path
+	// would deliver.  It is suitable for use as the `destMin` field of a strict
+	// send path payment.
+	Receive(amount xdr.Int64) (xdr.Int64, error)
+	// BestPrice returns the price, delimited in Source per Destination, of a
+	// vanishingly small payment along the path:  the product of the prices of
+	// the best offer on each order book the path crosses.
+	BestPrice() (*big.Rat, error)
+}
+
+// ErrNotEnough represents an error that occurs when pricing a trade on an
+// orderbook.  This error occurs when the orderbook cannot fulfill the
+// requested amount.
+var ErrNotEnough = errors.New("not enough depth")
+
+// OrderBooks is the view of the order books of a network that Search and
+// SearchStrictSend run against.  Each method that prices a trade returns
+// ErrNotEnough when the order book cannot fulfill it.
+type OrderBooks interface {
+	// ConnectedAssets returns the assets bought by the offers selling
+	// `selling`.
+	ConnectedAssets(selling xdr.Asset) ([]xdr.Asset, error)
+	// ConnectedSellingAssets returns the assets sold by the offers buying
+	// `buying`.
+	ConnectedSellingAssets(buying xdr.Asset) ([]xdr.Asset, error)
+	// Cost returns the amount of `buying` needed to buy `amount` of `selling`
+	// from the offers selling `selling` for `buying`, taking offers from the
+	// best price.
+	Cost(selling, buying xdr.Asset, amount xdr.Int64) (xdr.Int64, error)
+	// Receive returns the amount of `selling` received in exchange for
+	// `amount` of `buying` from the offers selling `selling` for `buying`,
+	// taking offers from the best price.
+	Receive(selling, buying xdr.Asset, amount xdr.Int64) (xdr.Int64, error)
+	// BestPrice returns the price, delimited in `buying` per `selling`, of the
+	// best offer selling `selling` for `buying`.
+	BestPrice(selling, buying xdr.Asset) (*big.Rat, error)
 }
 
 // Finder finds paths.
 type Finder interface {
+	// Find finds paths that deliver a fixed amount of the query's destination
+	// asset.
 	Find(Query) ([]Path, error)
+	// FindStrictSend finds paths that send a fixed amount of the query's source
+	// asset.
+	FindStrictSend(Query) ([]Path, error)
 }
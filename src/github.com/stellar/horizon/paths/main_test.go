@@ -0,0 +1,62 @@
+package paths
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stellar/go/xdr"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestQueryLimits(t *testing.T) {
+	var q Query
+	assert.Equal(t, DefaultMaxPaths, q.PathLimit())
+	assert.Equal(t, MaxHopsLimit, q.HopLimit())
+
+	q = Query{MaxPaths: 1, MaxHops: 2}
+	assert.Equal(t, 1, q.PathLimit())
+	assert.Equal(t, 2, q.HopLimit())
+
+	q = Query{MaxPaths: MaxPathsLimit + 1, MaxHops: MaxHopsLimit + 1}
+	assert.Equal(t, MaxPathsLimit, q.PathLimit())
+	assert.Equal(t, MaxHopsLimit, q.HopLimit())
+}
+
+func TestSort(t *testing.T) {
+	ps := []Path{
+		pricedPath{id: 1, price: 3},
+		pricedPath{id: 2, price: 1},
+		pricedPath{id: 3, price: 2},
+		pricedPath{id: 4, price: 1},
+	}
+
+	err := SortByCost(ps, 10)
+	if assert.NoError(t, err) {
+		assert.Equal(t, []int{2, 4, 3, 1}, ids(ps))
+	}
+
+	err = SortByReceived(ps, 10)
+	if assert.NoError(t, err) {
+		assert.Equal(t, []int{1, 3, 2, 4}, ids(ps))
+	}
+}
+
+// pricedPath is a Path whose cost and amount received are each the amount
+// multiplied by its price.
+type pricedPath struct {
+	DummyPath
+	id    int
+	price xdr.Int64
+}
+
+func (p pricedPath) Cost(amount xdr.Int64) (xdr.Int64, error)    { return amount * p.price, nil }
+func (p pricedPath) Receive(amount xdr.Int64) (xdr.Int64, error) { return amount * p.price, nil }
+func (p pricedPath) BestPrice() (*big.Rat, error)                { return big.NewRat(int64(p.price), 1), nil }
+
+func ids(ps []Path) []int {
+	result := make([]int, len(ps))
+	for i, p := range ps {
+		result[i] = p.(pricedPath).id
+	}
+	return result
+}
@@ -1,24 +1,24 @@
-package simplepath
+package paths
 
 import (
 	"bytes"
 	"fmt"
+	"math/big"
 
 	"github.com/stellar/go/xdr"
-	"github.com/stellar/horizon/db2/core"
-	"github.com/stellar/horizon/paths"
 )
 
-// pathNode implements the paths.Path interface and represents a path
-// as a linked list pointing from source to destination.
+// pathNode implements the Path interface and represents a path as a linked
+// list pointing from source to destination.  It is priced against the order
+// books it was found in.
 type pathNode struct {
 	Asset xdr.Asset
 	Tail  *pathNode
-	Q     *core.Q
+	Books OrderBooks
 }
 
 // check interface compatibility
-var _ paths.Path = &pathNode{}
+var _ Path = &pathNode{}
 
 func (p *pathNode) String() string {
 	if p == nil {
@@ -38,7 +38,7 @@ func (p *pathNode) String() string {
 	return out.String()
 }
 
-// Destination implements paths.Path.Destination interface method
+// Destination implements Path.Destination interface method
 func (p *pathNode) Destination() xdr.Asset {
 	cur := p
 	for cur.Tail != nil {
@@ -47,13 +47,13 @@ func (p *pathNode) Destination() xdr.Asset {
 	return cur.Asset
 }
 
-// Source implements paths.Path.Source interface method
+// Source implements Path.Source interface method
 func (p *pathNode) Source() xdr.Asset {
 	// the destination for path is the head of the linked list
 	return p.Asset
 }
 
-// Path implements paths.Path.Path interface method
+// Path implements Path.Path interface method
 func (p *pathNode) Path() []xdr.Asset {
 	path := p.Flatten()
 
@@ -66,19 +66,29 @@ func (p *pathNode) Path() []xdr.Asset {
 	return path[1 : len(path)-1]
 }
 
-// Cost implements the paths.Path.Cost interface method
+// Cost implements the Path.Cost interface method
 func (p *pathNode) Cost(amount xdr.Int64) (result xdr.Int64, err error) {
 	result = amount
+	cur := p
 
-	if p.Tail == nil {
-		return
+	for cur.Tail != nil {
+		result, err = p.Books.Cost(cur.Tail.Asset, cur.Asset, result)
+		if err != nil {
+			return
+		}
+		cur = cur.Tail
 	}
 
+	return
+}
+
+// Receive implements the Path.Receive interface method
+func (p *pathNode) Receive(amount xdr.Int64) (result xdr.Int64, err error) {
+	result = amount
 	cur := p
 
 	for cur.Tail != nil {
-		ob := cur.OrderBook()
-		result, err = ob.Cost(cur.Tail.Asset, result)
+		result, err = p.Books.Receive(cur.Tail.Asset, cur.Asset, result)
 		if err != nil {
 			return
 		}
@@ -88,6 +98,36 @@ func (p *pathNode) Cost(amount xdr.Int64) (result xdr.Int64, err error) {
 	return
 }
 
+// BestPrice implements the Path.BestPrice interface method
+func (p *pathNode) BestPrice() (*big.Rat, error) {
+	result := big.NewRat(1, 1)
+
+	for cur := p; cur.Tail != nil; cur = cur.Tail {
+		price, err := p.Books.BestPrice(cur.Tail.Asset, cur.Asset)
+		if err != nil {
+			return nil, err
+		}
+		result.Mul(result, price)
+	}
+
+	return result, nil
+}
+
+// Reverse returns a copy of the list in reverse order
+func (p *pathNode) Reverse() *pathNode {
+	var result *pathNode
+
+	for cur := p; cur != nil; cur = cur.Tail {
+		result = &pathNode{
+			Asset: cur.Asset,
+			Tail:  result,
+			Books: cur.Books,
+		}
+	}
+
+	return result
+}
+
 // Depth returns the length of the list
 func (p *pathNode) Depth() int {
 	depth := 0
@@ -113,15 +153,3 @@ func (p *pathNode) Flatten() (result []xdr.Asset) {
 		cur = cur.Tail
 	}
 }
-
-func (p *pathNode) OrderBook() *orderBook {
-	if p.Tail == nil {
-		return nil
-	}
-
-	return &orderBook{
-		Selling: p.Tail.Asset,
-		Buying:  p.Asset,
-		Q:       p.Q,
-	}
-}
@@ -0,0 +1,235 @@
+package paths
+
+import (
+	"github.com/stellar/go/xdr"
+)
+
+// maxCandidates is the number of paths a search collects before it stops.  A
+// search finds the paths crossing the fewest order books first, and so it
+// collects more paths than the query's path limit, so that a longer but
+// cheaper path is found before the results are sorted and truncated.
+const maxCandidates = 200
+
+// Search performs a breadth first search of `books` for the paths from the
+// query's source assets to `dest` that can deliver the query's destination
+// amount.  The search walks backwards from `dest`, and stops once the hop
+// limit or maxCandidates is reached.  The paths are returned in the order they
+// were found; see SortByCost.
+func Search(q Query, dest xdr.Asset, books OrderBooks) ([]Path, error) {
+	s := &search{
+		Query: q,
+		Books: books,
+	}
+
+	s.Init(dest, q.SourceAssets)
+	s.Run()
+	return s.Results, s.Err
+}
+
+// SearchStrictSend performs a breadth first search of `books` for the paths
+// from the query's source asset to any of its destination assets along which
+// the query's source amount can be sent.  A query without destination assets
+// finds the paths to any asset.  The paths are returned in the order
+// they were found; see SortByReceived.
+func SearchStrictSend(q Query, books OrderBooks) ([]Path, error) {
+	s := &search{
+		Query:      q,
+		Books:      books,
+		StrictSend: true,
+	}
+
+	s.Init(q.SourceAsset, q.DestinationAssets)
+	s.Run()
+	return s.Results, s.Err
+}
+
+// search represents a single query against a set of order books.  It provides
+// a place to store the results of the query, mostly for the purposes of code
+// clarity.
+//
+// A strict-receive search walks backwards from the destination asset, and so
+// the nodes in its queue are linked from the asset most recently reached to
+// the destination.  A strict-send search walks forwards from the source asset,
+// and so the nodes in its queue are linked from the asset most recently
+// reached back to the source.  Each of its results is reversed before being
+// recorded, so that it may be used as any other pathNode.
+//
+// The search struct is used as follows:
+//
+// 1.  Create an instance, ensuring the Query and Books fields are set
+// 2.  Call Init() to populate dependent fields in the struct with their initial values
+// 3.  Call Run() to perform the search.
+type search struct {
+	Query      Query
+	Books      OrderBooks
+	StrictSend bool
+
+	// Fields below are initialized by a call to Init() after
+	// setting the fields above
+	queue   []*pathNode
+	start   string
+	targets map[string]bool
+	visited map[string]bool
+
+	//This fields below are initialized after the search is run
+	Err     error
+	Results []Path
+}
+
+// Init initialized the search from `start`, setting fields on the struct used
+// to hold state needed during the actual search.
+func (s *search) Init(start xdr.Asset, targets []xdr.Asset) {
+	s.queue = []*pathNode{
+		&pathNode{
+			Asset: start,
+			Tail:  nil,
+			Books: s.Books,
+		},
+	}
+
+	s.start = start.String()
+
+	// build a map of asset's string representation to check if a given node
+	// is one of the targets for our search.  Unfortunately, xdr.Asset is not suitable
+	// for use as a map key, and so we use its string representation.
+	s.targets = map[string]bool{}
+	for _, a := range targets {
+		s.targets[a.String()] = true
+	}
+
+	s.visited = map[string]bool{}
+	s.Err = nil
+	s.Results = nil
+}
+
+// Run triggers the search, which will populate the Results and Err
+// field for the search after completion.
+func (s *search) Run() {
+	if s.Err != nil {
+		return
+	}
+
+	for s.hasMore() {
+		s.runOnce()
+	}
+}
+
+// pop removes the head from the search queue, returning it to the caller
+func (s *search) pop() *pathNode {
+	next := s.queue[0]
+	s.queue = s.queue[1:]
+	return next
+}
+
+// returns false if the search should stop.
+func (s *search) hasMore() bool {
+	if s.Err != nil {
+		return false
+	}
+
+	if len(s.Results) >= maxCandidates {
+		return false
+	}
+
+	return len(s.queue) > 0
+}
+
+// isTarget returns true if the asset id provided is one of the targets
+// for this search (i.e. one of the requesting account's trusted assets).  A
+// search without targets is for any asset other than the one it starts from.
+func (s *search) isTarget(id string) bool {
+	if len(s.targets) == 0 {
+		return id != s.start
+	}
+
+	_, found := s.targets[id]
+	return found
+}
+
+// visit returns true if the asset id provided has not been
+// visited on this search, after marking the id as visited
+func (s *search) visit(id string) bool {
+	if _, found := s.visited[id]; found {
+		return false
+	}
+
+	s.visited[id] = true
+	return true
+}
+
+// runOnce processes the head of the search queue, findings results
+// and extending the search as necessary.
+func (s *search) runOnce() {
+	cur := s.pop()
+	id := cur.Asset.String()
+
+	if s.isTarget(id) {
+		if s.StrictSend {
+			s.Results = append(s.Results, cur.Reverse())
+		} else {
+			s.Results = append(s.Results, cur)
+		}
+	}
+
+	if !s.visit(id) {
+		return
+	}
+
+	// The linked list includes both source and destination in addition to the
+	// path, and so the current path crosses one order book fewer than its
+	// depth.  We abort our search if extending it would cross too many.
+	if cur.Depth() > s.Query.HopLimit() {
+		return
+	}
+
+	s.extendSearch(cur)
+}
+
+func (s *search) extendSearch(cur *pathNode) {
+	// find the assets that can be traded for the current asset
+	var connected []xdr.Asset
+	if s.StrictSend {
+		connected, s.Err = s.Books.ConnectedSellingAssets(cur.Asset)
+	} else {
+		connected, s.Err = s.Books.ConnectedAssets(cur.Asset)
+	}
+	if s.Err != nil {
+		return
+	}
+
+	for _, a := range connected {
+		newPath := &pathNode{
+			Asset: a,
+			Tail:  cur,
+			Books: s.Books,
+		}
+
+		var hasEnough bool
+		hasEnough, s.Err = s.hasEnoughDepth(newPath)
+		if s.Err != nil {
+			return
+		}
+
+		if !hasEnough {
+			continue
+		}
+
+		s.queue = append(s.queue, newPath)
+	}
+}
+
+// hasEnoughDepth returns whether the order books `path` crosses can fill the
+// query's amount.
+func (s *search) hasEnoughDepth(path *pathNode) (bool, error) {
+	var err error
+	if s.StrictSend {
+		_, err = path.Reverse().Receive(s.Query.SourceAmount)
+	} else {
+		_, err = path.Cost(s.Query.DestinationAmount)
+	}
+
+	if err == ErrNotEnough {
+		return false, nil
+	}
+	return true, err
+}
@@ -0,0 +1,208 @@
+package paths
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/stellar/go/xdr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSearch(t *testing.T) {
+	xlm, usd, eur := testAssets()
+
+	q := Query{
+		SourceAssets:      []xdr.Asset{xlm},
+		DestinationAmount: 10,
+	}
+
+	found, err := Search(q, eur, testBooks(xlm, usd, eur))
+	require.NoError(t, err)
+
+	// the direct order book is too shallow to deliver 10 EUR
+	require.Len(t, found, 1)
+	p := found[0]
+	assert.Equal(t, xlm, p.Source())
+	assert.Equal(t, eur, p.Destination())
+	assert.Equal(t, []xdr.Asset{usd}, p.Path())
+
+	cost, err := p.Cost(10)
+	if assert.NoError(t, err) {
+		assert.Equal(t, xdr.Int64(60), cost)
+	}
+
+	price, err := p.BestPrice()
+	if assert.NoError(t, err) {
+		assert.Equal(t, "6/1", price.String())
+	}
+
+	// the path through USD crosses two order books
+	q.MaxHops = 1
+	found, err = Search(q, eur, testBooks(xlm, usd, eur))
+	require.NoError(t, err)
+	assert.Empty(t, found)
+
+	_, err = Search(q, eur, failingBooks{})
+	assert.Error(t, err)
+}
+
+func TestSearch_CollectsPastPathLimit(t *testing.T) {
+	xlm, usd, eur := testAssets()
+
+	// the direct order book is deep enough, but dearer than the path through
+	// USD, which is found after it
+	books := fakeBooks{
+		{Selling: usd, Buying: xlm, Amount: 1000, Price: 2},
+		{Selling: eur, Buying: usd, Amount: 1000, Price: 3},
+		{Selling: eur, Buying: xlm, Amount: 1000, Price: 10},
+	}
+
+	q := Query{
+		SourceAssets:      []xdr.Asset{xlm},
+		DestinationAmount: 10,
+		MaxPaths:          1,
+	}
+
+	found, err := Search(q, eur, books)
+	require.NoError(t, err)
+	require.Len(t, found, 2)
+	assert.Empty(t, found[0].Path())
+
+	require.NoError(t, SortByCost(found, q.DestinationAmount))
+	assert.Equal(t, []xdr.Asset{usd}, found[0].Path())
+}
+
+func TestSearchStrictSend(t *testing.T) {
+	xlm, usd, eur := testAssets()
+
+	q := Query{
+		SourceAsset:       xlm,
+		SourceAmount:      60,
+		DestinationAssets: []xdr.Asset{eur},
+	}
+
+	found, err := SearchStrictSend(q, testBooks(xlm, usd, eur))
+	require.NoError(t, err)
+
+	// the direct order book is too shallow to receive 6 EUR
+	require.Len(t, found, 1)
+	p := found[0]
+	assert.Equal(t, xlm, p.Source())
+	assert.Equal(t, eur, p.Destination())
+	assert.Equal(t, []xdr.Asset{usd}, p.Path())
+
+	received, err := p.Receive(60)
+	if assert.NoError(t, err) {
+		assert.Equal(t, xdr.Int64(10), received)
+	}
+
+	_, err = SearchStrictSend(q, failingBooks{})
+	assert.Error(t, err)
+
+	// without destination assets, paths to every asset reached are found
+	q.DestinationAssets = nil
+	found, err = SearchStrictSend(q, testBooks(xlm, usd, eur))
+	require.NoError(t, err)
+	require.Len(t, found, 2)
+	assert.Equal(t, usd, found[0].Destination())
+	assert.Equal(t, eur, found[1].Destination())
+}
+
+func testAssets() (xlm, usd, eur xdr.Asset) {
+	issuer, err := xdr.NewAccountId(xdr.PublicKeyTypePublicKeyTypeEd25519, xdr.Uint256{})
+	if err != nil {
+		panic(err)
+	}
+
+	xlm.SetNative()
+	usd.SetCredit("USD", issuer)
+	eur.SetCredit("EUR", issuer)
+	return
+}
+
+// testBooks returns order books in which 10 EUR can be bought for 60 XLM
+// through USD, but not directly.
+func testBooks(xlm, usd, eur xdr.Asset) fakeBooks {
+	return fakeBooks{
+		{Selling: usd, Buying: xlm, Amount: 1000, Price: 2},
+		{Selling: eur, Buying: usd, Amount: 1000, Price: 3},
+		{Selling: eur, Buying: xlm, Amount: 5, Price: 10},
+	}
+}
+
+// fakeBook is an order book holding a single offer, whose price is a whole
+// number of the buying asset per the selling asset.
+type fakeBook struct {
+	Selling xdr.Asset
+	Buying  xdr.Asset
+	Amount  xdr.Int64
+	Price   xdr.Int64
+}
+
+type fakeBooks []fakeBook
+
+func (fb fakeBooks) ConnectedAssets(selling xdr.Asset) (result []xdr.Asset, err error) {
+	for _, b := range fb {
+		if b.Selling.Equals(selling) {
+			result = append(result, b.Buying)
+		}
+	}
+	return
+}
+
+func (fb fakeBooks) ConnectedSellingAssets(buying xdr.Asset) (result []xdr.Asset, err error) {
+	for _, b := range fb {
+		if b.Buying.Equals(buying) {
+			result = append(result, b.Selling)
+		}
+	}
+	return
+}
+
+func (fb fakeBooks) Cost(selling, buying xdr.Asset, amount xdr.Int64) (xdr.Int64, error) {
+	b, ok := fb.book(selling, buying)
+	if !ok || amount > b.Amount {
+		return 0, ErrNotEnough
+	}
+	return amount * b.Price, nil
+}
+
+func (fb fakeBooks) Receive(selling, buying xdr.Asset, amount xdr.Int64) (xdr.Int64, error) {
+	b, ok := fb.book(selling, buying)
+	if !ok || amount/b.Price > b.Amount {
+		return 0, ErrNotEnough
+	}
+	return amount / b.Price, nil
+}
+
+func (fb fakeBooks) BestPrice(selling, buying xdr.Asset) (*big.Rat, error) {
+	b, ok := fb.book(selling, buying)
+	if !ok {
+		return nil, ErrNotEnough
+	}
+	return big.NewRat(int64(b.Price), 1), nil
+}
+
+func (fb fakeBooks) book(selling, buying xdr.Asset) (fakeBook, bool) {
+	for _, b := range fb {
+		if b.Selling.Equals(selling) && b.Buying.Equals(buying) {
+			return b, true
+		}
+	}
+	return fakeBook{}, false
+}
+
+// failingBooks is a set of order books that cannot be read.
+type failingBooks struct {
+	fakeBooks
+}
+
+func (failingBooks) ConnectedAssets(xdr.Asset) ([]xdr.Asset, error) {
+	return nil, errors.New("broken")
+}
+
+func (failingBooks) ConnectedSellingAssets(xdr.Asset) ([]xdr.Asset, error) {
+	return nil, errors.New("broken")
+}
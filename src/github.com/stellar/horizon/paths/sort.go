@@ -0,0 +1,59 @@
+package paths
+
+import (
+	"sort"
+
+	"github.com/stellar/go/xdr"
+)
+
+// SortByCost sorts paths found by a strict-receive search from cheapest to
+// most expensive, given the amount to be delivered.
+func SortByCost(ps []Path, amount xdr.Int64) error {
+	return sortBy(ps, false, func(p Path) (xdr.Int64, error) {
+		return p.Cost(amount)
+	})
+}
+
+// SortByReceived sorts paths found by a strict-send search from the one
+// delivering the most to the one delivering the least, given the amount sent.
+func SortByReceived(ps []Path, amount xdr.Int64) error {
+	return sortBy(ps, true, func(p Path) (xdr.Int64, error) {
+		return p.Receive(amount)
+	})
+}
+
+func sortBy(ps []Path, desc bool, key func(Path) (xdr.Int64, error)) error {
+	s := byKey{Paths: ps, Keys: make([]xdr.Int64, len(ps)), Desc: desc}
+
+	for i, p := range ps {
+		var err error
+		s.Keys[i], err = key(p)
+		if err != nil {
+			return err
+		}
+	}
+
+	sort.Stable(s)
+	return nil
+}
+
+// byKey sorts paths by a precomputed amount each.
+type byKey struct {
+	Paths []Path
+	Keys  []xdr.Int64
+	Desc  bool
+}
+
+func (s byKey) Len() int { return len(s.Paths) }
+
+func (s byKey) Less(i, j int) bool {
+	if s.Desc {
+		return s.Keys[i] > s.Keys[j]
+	}
+	return s.Keys[i] < s.Keys[j]
+}
+
+func (s byKey) Swap(i, j int) {
+	s.Paths[i], s.Paths[j] = s.Paths[j], s.Paths[i]
+	s.Keys[i], s.Keys[j] = s.Keys[j], s.Keys[i]
+}
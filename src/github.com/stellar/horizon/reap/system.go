@@ -3,7 +3,9 @@ package reap
 import (
 	"time"
 
+	"github.com/stellar/horizon/db2/history"
 	"github.com/stellar/horizon/errors"
+	"github.com/stellar/horizon/ingest"
 	"github.com/stellar/horizon/ledger"
 	"github.com/stellar/horizon/log"
 	"github.com/stellar/horizon/toid"
@@ -69,6 +71,15 @@ func (r *System) clearBefore(seq int32) error {
 	clear := r.HorizonDB.DeleteRange
 	end := toid.New(seq, 0, 0).ToInt64()
 
+	for _, p := range ingest.Processors() {
+		for _, table := range p.Tables() {
+			err := clear(0, end, table.Name, table.IDColumn)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
 	err := clear(0, end, "history_effects", "history_operation_id")
 	if err != nil {
 		return err
@@ -93,6 +104,12 @@ func (r *System) clearBefore(seq int32) error {
 	if err != nil {
 		return err
 	}
+	// outbox events are kept until every export has delivered them
+	q := &history.Q{Session: r.HorizonDB}
+	err = q.DeleteDeliveredOutboxEvents(end)
+	if err != nil {
+		return err
+	}
 
 	return nil
 }
@@ -3,7 +3,9 @@ package reap
 import (
 	"testing"
 
+	"github.com/stellar/horizon/db2/history"
 	"github.com/stellar/horizon/test"
+	"github.com/stellar/horizon/toid"
 )
 
 func TestDeleteUnretainedHistory(t *testing.T) {
@@ -46,3 +48,57 @@ func TestDeleteUnretainedHistory(t *testing.T) {
 		tt.Assert.Equal(1, cur)
 	}
 }
+
+func TestDeleteUnretainedHistory_Outbox(t *testing.T) {
+	tt := test.Start(t).Scenario("kahuna")
+	defer tt.Finish()
+
+	db := tt.HorizonSession()
+	sys := New(1, db)
+
+	// an event for each ledger, each written by its own transaction
+	var ledgers []int32
+	tt.Require.NoError(db.SelectRaw(&ledgers, `SELECT sequence FROM history_ledgers ORDER BY sequence`))
+	for _, seq := range ledgers {
+		_, err := db.ExecRaw(`
+			INSERT INTO outbox_events (type, history_id, ledger_sequence, details, created_at)
+			VALUES ('ledger_closed', ?, ?, '{}', now())
+		`, toid.New(seq, 0, 0).ToInt64(), seq)
+		tt.Require.NoError(err)
+	}
+
+	remaining := func() (n int) {
+		tt.Require.NoError(db.GetRaw(&n, `SELECT COUNT(*) FROM outbox_events`))
+		return
+	}
+
+	// export `name` has delivered the events up to ledger `seq`
+	deliver := func(name string, seq int32) {
+		var pos history.OutboxPosition
+		err := db.GetRaw(&pos, `
+			SELECT txid AS last_txid, id AS last_event_id
+			FROM outbox_events WHERE ledger_sequence = ?
+		`, seq)
+		tt.Require.NoError(err)
+		q := &history.Q{Session: db}
+		tt.Require.NoError(q.UpdateExportCursor(name, pos))
+	}
+
+	// no event is deleted before it has been exported
+	tt.UpdateLedgerState()
+	tt.Require.NoError(sys.DeleteUnretainedHistory())
+	tt.Assert.Equal(len(ledgers), remaining())
+
+	// only the events delivered by the slowest export are deleted
+	deliver("fast", ledgers[len(ledgers)-1])
+	deliver("slow", ledgers[4])
+	tt.UpdateLedgerState()
+	tt.Require.NoError(sys.DeleteUnretainedHistory())
+	tt.Assert.Equal(len(ledgers)-5, remaining())
+
+	// and no event of a retained ledger is deleted
+	deliver("slow", ledgers[len(ledgers)-1])
+	tt.UpdateLedgerState()
+	tt.Require.NoError(sys.DeleteUnretainedHistory())
+	tt.Assert.Equal(1, remaining())
+}
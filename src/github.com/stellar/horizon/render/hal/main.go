@@ -16,6 +16,12 @@ func RenderToString(data interface{}, pretty bool) ([]byte, error) {
 
 // Render write data to w, after marshalling to json
 func Render(w http.ResponseWriter, data interface{}) {
+	RenderStatus(w, http.StatusOK, data)
+}
+
+// RenderStatus writes data to w with the provided http status code, after
+// marshalling to json
+func RenderStatus(w http.ResponseWriter, status int, data interface{}) {
 	js, err := RenderToString(data, true)
 
 	if err != nil {
@@ -25,5 +31,6 @@ func Render(w http.ResponseWriter, data interface{}) {
 
 	w.Header().Set("Content-Disposition", "inline")
 	w.Header().Set("Content-Type", "application/hal+json; charset=utf-8")
+	w.WriteHeader(status)
 	w.Write(js)
 }
@@ -5,12 +5,19 @@ import (
 
 	"bitbucket.org/ww/goautoneg"
 	"github.com/stellar/horizon/log"
+	"github.com/stellar/horizon/render/ws"
 	"golang.org/x/net/context"
 )
 
 // Negotiate inspects the Accept header of the provided request and determines
 // what the most appropriate response type should be.  Defaults to HAL.
+// WebSocket handshakes are always negotiated to be MimeEventStream, as every
+// streaming response can be sent over a WebSocket.
 func Negotiate(ctx context.Context, r *http.Request) string {
+	if ws.IsUpgrade(r) {
+		return MimeEventStream
+	}
+
 	alternatives := []string{MimeHal, MimeJSON, MimeEventStream, MimeRaw}
 	accept := r.Header.Get("Accept")
 
@@ -36,6 +36,13 @@ func TestRenderPackage(t *testing.T) {
 			So(Negotiate(ctx, r), ShouldEqual, MimeHal)
 		})
 
+		Convey("Negotiates websocket handshakes as streams", func() {
+			r.Header.Set("Accept", "")
+			r.Header.Set("Connection", "Upgrade")
+			r.Header.Set("Upgrade", "websocket")
+			So(Negotiate(ctx, r), ShouldEqual, MimeEventStream)
+		})
+
 		Convey("Returns empty string for invalid type", func() {
 			r.Header.Set("Accept", "text/plain")
 			So(Negotiate(ctx, r), ShouldEqual, "")
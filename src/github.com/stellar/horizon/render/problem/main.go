@@ -101,6 +101,30 @@ func render(ctx context.Context, w http.ResponseWriter, p P) {
 }
 
 func renderErr(ctx context.Context, w http.ResponseWriter, err error) {
+	render(ctx, w, fromErr(ctx, err))
+}
+
+// FromError returns the inflated problem that `err` would be rendered as,
+// without rendering it.  It is useful when a problem is embedded in a larger
+// response.
+func FromError(ctx context.Context, err error) P {
+	var p P
+
+	switch err := err.(type) {
+	case *P:
+		p = *err
+	case HasProblem:
+		p = err.Problem()
+	default:
+		p = fromErr(ctx, err)
+	}
+
+	Inflate(ctx, &p)
+	return p
+}
+
+// fromErr returns the problem registered for `err`, or a ServerError.
+func fromErr(ctx context.Context, err error) P {
 	origErr := err
 
 	if err, ok := err.(*errors.Error); ok {
@@ -116,7 +140,7 @@ func renderErr(ctx context.Context, w http.ResponseWriter, err error) {
 		p = ServerError
 	}
 
-	render(ctx, w, p)
+	return p
 }
 
 // Well-known and reused problems below:
@@ -196,6 +220,17 @@ var (
 			"several minutes before trying your request again.",
 	}
 
+	// AccountOverCapacity is a well-known problem type.  Use it as a shortcut
+	// in your actions.
+	AccountOverCapacity = P{
+		Type:   "account_over_capacity",
+		Title:  "Account Over Capacity",
+		Status: http.StatusTooManyRequests,
+		Detail: "Too many transactions from the source account of this " +
+			"transaction are waiting to be submitted to stellar-core.  Please " +
+			"wait for some of them to complete before trying your request again.",
+	}
+
 	// Timeout is a well-known problem type.  Use it as a shortcut
 	// in your actions.
 	Timeout = P{
@@ -110,4 +110,20 @@ func TestProblemPackage(t *testing.T) {
 		})
 	})
 
+	Convey("problem.FromError", t, func() {
+		Convey("returns problems as they are, inflated", func() {
+			p := FromError(requestid.Context(ctx, "2"), &NotFound)
+			So(p.Status, ShouldEqual, 404)
+			So(p.Type, ShouldEqual, "https://stellar.org/horizon-errors/not_found")
+			So(p.Instance, ShouldEqual, "2")
+		})
+
+		Convey("converts other errors to ServerError problems", func() {
+			ctx, _ := test.ContextWithLogBuffer()
+			p := FromError(ctx, errors.New("broke"))
+			So(p.Status, ShouldEqual, 500)
+			So(p.Detail, ShouldNotContainSubstring, "broke")
+		})
+	})
+
 }
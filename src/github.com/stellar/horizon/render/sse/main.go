@@ -35,6 +35,8 @@ type Eventable interface {
 // Pumped returns a channel that will be closed the next time the input pump
 // sends.  It can be used similar to `ctx.Done()`, like so:  `<-sse.Pumped()`
 func Pumped() <-chan struct{} {
+	lock.Lock()
+	defer lock.Unlock()
 	return nextTick
 }
 
@@ -67,7 +69,7 @@ func WritePreamble(ctx context.Context, w http.ResponseWriter) bool {
 	w.Header().Set("Access-Control-Allow-Origin", "*")
 	w.WriteHeader(200)
 
-	WriteEvent(ctx, w, helloEvent)
+	WriteEvent(ctx, w, HelloEvent)
 
 	return true
 }
@@ -100,20 +102,20 @@ func WriteEvent(ctx context.Context, w http.ResponseWriter, e Event) {
 	w.(http.Flusher).Flush()
 }
 
-// Upon successful completion of a query (i.e. the client didn't disconnect
-// and we didn't error) we send a "Goodbye" event.  This is a dummy event
+// GoodbyeEvent is sent upon successful completion of a query (i.e. the client
+// didn't disconnect and we didn't error).  This is a dummy event
 // so that we can set a low retry value so that the client will immediately
 // recoonnect and request more data.  This helpes to give the feel of a infinite
 // stream of data, even though we're actually responding in PAGE_SIZE chunks.
-var goodbyeEvent = Event{
+var GoodbyeEvent = Event{
 	Data:  "byebye",
 	Event: "close",
 	Retry: 10,
 }
 
-// Upon initial stream creation, we send this event to inform the client
-// that they may retry an errored connection after 1 second.
-var helloEvent = Event{
+// HelloEvent is sent upon initial stream creation, to inform the client that
+// they may retry an errored connection after 1 second.
+var HelloEvent = Event{
 	Data:  "hello",
 	Event: "open",
 	Retry: 1000,
@@ -53,7 +53,7 @@ func (s *stream) SetLimit(limit int) {
 }
 
 func (s *stream) Done() {
-	WriteEvent(s.ctx, s.w, goodbyeEvent)
+	WriteEvent(s.ctx, s.w, GoodbyeEvent)
 	s.done = true
 }
 
@@ -0,0 +1,6 @@
+// Package ws contains the WebSocket transport used by horizon's streaming
+// endpoints.  It implements sse.Stream, so that every action that can respond
+// with Server Sent Events can also respond over a WebSocket: each event is
+// sent as a single JSON text message carrying the same id, event, retry and
+// data fields that would have been written to an event stream.
+package ws
@@ -0,0 +1,60 @@
+package ws
+
+import (
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/stellar/horizon/render/sse"
+)
+
+// WriteTimeout is the maximum amount of time spent writing a single message to
+// a client before the connection is considered lost.
+const WriteTimeout = 10 * time.Second
+
+// Message is the JSON form of an sse.Event, as sent over a WebSocket.
+type Message struct {
+	ID    string      `json:"id,omitempty"`
+	Event string      `json:"event,omitempty"`
+	Retry int         `json:"retry,omitempty"`
+	Data  interface{} `json:"data"`
+}
+
+// IsUpgrade returns true if `r` is a request to open a WebSocket.
+func IsUpgrade(r *http.Request) bool {
+	return websocket.IsWebSocketUpgrade(r)
+}
+
+// NewMessage converts `e` into the message sent to clients.  Errors are sent
+// as an "err" event whose data is the error message, as they are for event
+// streams.
+func NewMessage(e sse.Event) Message {
+	if e.Error != nil {
+		return Message{Event: "err", Data: e.Error.Error()}
+	}
+
+	return Message{
+		ID:    e.ID,
+		Event: e.Event,
+		Retry: e.Retry,
+		Data:  e.Data,
+	}
+}
+
+// WriteEvent sends `e` to the client connected to `conn`.
+func WriteEvent(conn *websocket.Conn, e sse.Event) error {
+	js, err := json.Marshal(NewMessage(e))
+	if err != nil {
+		return err
+	}
+
+	conn.SetWriteDeadline(time.Now().Add(WriteTimeout))
+	return conn.WriteMessage(websocket.TextMessage, js)
+}
+
+// horizon streams are readable by any origin, just as event streams are
+// served with `Access-Control-Allow-Origin: *`.
+var upgrader = websocket.Upgrader{
+	CheckOrigin: func(r *http.Request) bool { return true },
+}
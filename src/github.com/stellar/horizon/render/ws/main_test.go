@@ -0,0 +1,70 @@
+package ws
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gorilla/websocket"
+	"github.com/stellar/horizon/render/sse"
+	"github.com/stellar/horizon/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewMessage(t *testing.T) {
+	msg := NewMessage(sse.Event{ID: "1", Event: "test", Retry: 10, Data: "test"})
+	assert.Equal(t, Message{ID: "1", Event: "test", Retry: 10, Data: "test"}, msg)
+
+	msg = NewMessage(sse.Event{ID: "1", Error: errors.New("busted")})
+	assert.Equal(t, Message{Event: "err", Data: "busted"}, msg)
+}
+
+func TestStream(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !IsUpgrade(r) {
+			http.Error(w, "not a websocket", http.StatusBadRequest)
+			return
+		}
+
+		stream := NewStream(test.Context(), w, r)
+		defer stream.Close()
+
+		stream.SetLimit(10)
+		stream.Send(sse.Event{ID: "1", Data: "first"})
+		stream.Send(sse.Event{ID: "2", Data: "second"})
+		stream.Done()
+	}))
+	defer srv.Close()
+
+	url := "ws" + strings.TrimPrefix(srv.URL, "http")
+	conn, _, err := websocket.DefaultDialer.Dial(url, nil)
+	require.NoError(t, err)
+	defer conn.Close()
+
+	var got []Message
+	for {
+		var msg Message
+		err := conn.ReadJSON(&msg)
+		if err != nil {
+			assert.True(t, websocket.IsCloseError(err, websocket.CloseNormalClosure))
+			break
+		}
+		got = append(got, msg)
+	}
+
+	assert.Equal(t, []Message{
+		{Event: "open", Retry: 1000, Data: "hello"},
+		{ID: "1", Data: "first"},
+		{ID: "2", Data: "second"},
+		{Event: "close", Retry: 10, Data: "byebye"},
+	}, got)
+
+	// plain requests are left alone
+	resp, err := http.Get(srv.URL)
+	require.NoError(t, err)
+	resp.Body.Close()
+	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+}
@@ -0,0 +1,165 @@
+package ws
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/stellar/horizon/log"
+	"github.com/stellar/horizon/render/sse"
+	"golang.org/x/net/context"
+)
+
+// NewStream creates a new stream that responds to the provided WebSocket
+// handshake.  The connection is upgraded when the first event is sent, so that
+// a request that fails before then can still be answered with a normal http
+// response.  Callers must Close the stream once they are finished with it.
+func NewStream(ctx context.Context, w http.ResponseWriter, r *http.Request) *Stream {
+	ctx, cancel := context.WithCancel(ctx)
+	return &Stream{ctx: ctx, cancel: cancel, w: w, r: r}
+}
+
+// Stream is an sse.Stream that sends events over a WebSocket.
+type Stream struct {
+	ctx    context.Context
+	cancel func()
+	w      http.ResponseWriter
+	r      *http.Request
+	conn   *websocket.Conn
+	done   bool
+	sent   int
+	limit  int
+}
+
+var _ sse.Stream = &Stream{}
+
+// Context returns a context that is canceled once the client disconnects or
+// the stream is closed.  It should be used in place of the context the stream
+// was created with while streaming.
+func (s *Stream) Context() context.Context {
+	return s.ctx
+}
+
+// Close closes the connection, if it is still open, and releases the
+// stream's resources.  The client is expected to reconnect, resuming from the
+// last id it received, just as it would after an event stream ends.
+func (s *Stream) Close() {
+	if !s.done {
+		s.close()
+	}
+	s.cancel()
+}
+
+// Send is a method for sse.Stream
+func (s *Stream) Send(e sse.Event) {
+	if s.done {
+		return
+	}
+
+	if s.sent == 0 {
+		ok := s.upgrade()
+		if !ok {
+			s.done = true
+			return
+		}
+	}
+
+	s.write(e)
+	s.sent++
+}
+
+// SentCount is a method for sse.Stream
+func (s *Stream) SentCount() int {
+	return s.sent
+}
+
+// SetLimit is a method for sse.Stream
+func (s *Stream) SetLimit(limit int) {
+	s.limit = limit
+}
+
+// Done is a method for sse.Stream
+func (s *Stream) Done() {
+	if s.conn == nil && !s.upgrade() {
+		s.done = true
+		return
+	}
+
+	s.write(sse.GoodbyeEvent)
+	s.close()
+}
+
+// IsDone is a method for sse.Stream
+func (s *Stream) IsDone() bool {
+	if s.limit == 0 {
+		return s.done
+	}
+
+	return s.done || s.sent >= s.limit
+}
+
+// Err is a method for sse.Stream
+func (s *Stream) Err(err error) {
+	log.Ctx(s.ctx).Error(err)
+	s.write(sse.Event{Error: err})
+	s.close()
+}
+
+// upgrade completes the WebSocket handshake and sends the hello event.  On
+// failure, the upgrader has already responded to the client with an http
+// error.
+func (s *Stream) upgrade() bool {
+	conn, err := upgrader.Upgrade(s.w, s.r, nil)
+	if err != nil {
+		log.Ctx(s.ctx).WithField("err", err.Error()).Warn("websocket upgrade failed")
+		s.cancel()
+		return false
+	}
+
+	s.conn = conn
+
+	// The client never sends us anything meaningful, but reading is the only
+	// way to notice that it has gone away.
+	go func() {
+		for {
+			if _, _, err := conn.NextReader(); err != nil {
+				s.cancel()
+				return
+			}
+		}
+	}()
+
+	go func() {
+		<-s.ctx.Done()
+		conn.Close()
+	}()
+
+	s.write(sse.HelloEvent)
+	return true
+}
+
+func (s *Stream) write(e sse.Event) {
+	if s.conn == nil {
+		return
+	}
+
+	err := WriteEvent(s.conn, e)
+	if err != nil {
+		s.done = true
+		s.cancel()
+	}
+}
+
+// close performs the closing handshake and marks the stream as done.  The
+// client is expected to reconnect, resuming from the last id it received.
+func (s *Stream) close() {
+	s.done = true
+	if s.conn == nil {
+		return
+	}
+
+	msg := websocket.FormatCloseMessage(websocket.CloseNormalClosure, "")
+	s.conn.SetWriteDeadline(time.Now().Add(WriteTimeout))
+	s.conn.WriteMessage(websocket.CloseMessage, msg)
+	s.cancel()
+}
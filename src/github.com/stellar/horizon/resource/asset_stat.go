@@ -0,0 +1,31 @@
+package resource
+
+import (
+	"github.com/stellar/go/amount"
+	"github.com/stellar/go/xdr"
+	"github.com/stellar/horizon/db2/history"
+	"github.com/stellar/horizon/httpx"
+	"github.com/stellar/horizon/render/hal"
+	"golang.org/x/net/context"
+)
+
+// Populate fills out the details of an asset stat using a row from the
+// asset_stats table.
+func (res *AssetStat) Populate(ctx context.Context, row history.AssetStat) {
+	res.AssetType = row.AssetType
+	res.AssetCode = row.AssetCode
+	res.AssetIssuer = row.AssetIssuer
+	res.PT = row.PagingToken()
+	res.Amount = amount.String(row.Amount)
+	res.NumAccounts = row.NumAccounts
+	res.Flags.AuthRequired = row.Flags&xdr.AccountFlagsAuthRequiredFlag != 0
+	res.Flags.AuthRevocable = row.Flags&xdr.AccountFlagsAuthRevocableFlag != 0
+
+	lb := hal.LinkBuilder{httpx.BaseURL(ctx)}
+	res.Links.Issuer = lb.Link("/accounts", res.AssetIssuer)
+}
+
+// PagingToken implementation for hal.Pageable
+func (res AssetStat) PagingToken() string {
+	return res.PT
+}
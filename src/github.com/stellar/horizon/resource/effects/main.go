@@ -99,6 +99,18 @@ func New(
 		e := TrustlineDeauthorized{Base: basev}
 		err = row.UnmarshalDetails(&e)
 		result = e
+	case history.EffectOfferCreated:
+		e := OfferCreated{Base: basev}
+		err = row.UnmarshalDetails(&e)
+		result = e
+	case history.EffectOfferUpdated:
+		e := OfferUpdated{Base: basev}
+		err = row.UnmarshalDetails(&e)
+		result = e
+	case history.EffectOfferRemoved:
+		e := OfferRemoved{Base: basev}
+		err = row.UnmarshalDetails(&e)
+		result = e
 	case history.EffectTrade:
 		e := Trade{Base: basev}
 		err = row.UnmarshalDetails(&e)
@@ -223,6 +235,34 @@ type TrustlineDeauthorized struct {
 	AssetCode string `json:"asset_code,omitempty"`
 }
 
+type Offer struct {
+	OfferID            int64      `json:"offer_id"`
+	Amount             string     `json:"amount"`
+	Price              string     `json:"price"`
+	PriceR             base.Price `json:"price_r"`
+	SellingAssetType   string     `json:"selling_asset_type"`
+	SellingAssetCode   string     `json:"selling_asset_code,omitempty"`
+	SellingAssetIssuer string     `json:"selling_asset_issuer,omitempty"`
+	BuyingAssetType    string     `json:"buying_asset_type"`
+	BuyingAssetCode    string     `json:"buying_asset_code,omitempty"`
+	BuyingAssetIssuer  string     `json:"buying_asset_issuer,omitempty"`
+}
+
+type OfferCreated struct {
+	Base
+	Offer
+}
+
+type OfferUpdated struct {
+	Base
+	Offer
+}
+
+type OfferRemoved struct {
+	Base
+	Offer
+}
+
 type Trade struct {
 	Base
 	Seller            string `json:"seller"`
@@ -0,0 +1,108 @@
+package resource
+
+import (
+	"database/sql"
+	"fmt"
+
+	"github.com/stellar/go/amount"
+	"github.com/stellar/horizon/db2/history"
+	"github.com/stellar/horizon/httpx"
+	"github.com/stellar/horizon/render/hal"
+	"github.com/stellar/horizon/resource/effects"
+	"golang.org/x/net/context"
+)
+
+const (
+	// OfferStatusFilled is the status of an offer that was removed from the
+	// ledger by the trade that consumed the last of its amount.
+	OfferStatusFilled = "filled"
+
+	// OfferStatusPartiallyFilled is the status of an offer that traded some of
+	// its amount before being removed from the ledger without being filled.
+	OfferStatusPartiallyFilled = "partially_filled"
+
+	// OfferStatusCancelled is the status of an offer that was removed from the
+	// ledger without ever trading.
+	OfferStatusCancelled = "cancelled"
+)
+
+// Populate fills out the details of the offer identified by `id` using the
+// latest of its offer_created, offer_updated and offer_removed effects, which
+// may be nil, and the totals of the trades made against it.  `lastTrade`, the
+// latest of those trades, is only used when `last` is nil.  Returns
+// sql.ErrNoRows if history knows nothing of the offer.
+func (this *HistoricalOffer) Populate(
+	ctx context.Context,
+	id int64,
+	last *history.Effect,
+	trades history.OfferTrades,
+	lastTrade *history.Trade,
+) error {
+	if last == nil && trades.Count == 0 {
+		return sql.ErrNoRows
+	}
+
+	this.ID = id
+	this.PT = fmt.Sprintf("%d", id)
+	this.AmountSold = amount.String(trades.SoldAmount)
+	this.AmountBought = amount.String(trades.BoughtAmount)
+
+	if last != nil {
+		var offer effects.Offer
+		err := last.UnmarshalDetails(&offer)
+		if err != nil {
+			return err
+		}
+
+		this.Seller = last.Account
+		this.Amount = offer.Amount
+		this.Price = offer.Price
+		this.PriceR = Price(offer.PriceR)
+		this.Selling = Asset{
+			Type:   offer.SellingAssetType,
+			Code:   offer.SellingAssetCode,
+			Issuer: offer.SellingAssetIssuer,
+		}
+		this.Buying = Asset{
+			Type:   offer.BuyingAssetType,
+			Code:   offer.BuyingAssetCode,
+			Issuer: offer.BuyingAssetIssuer,
+		}
+	} else {
+		// history was ingested before offer effects were recorded, so all that
+		// is known about the offer comes from the trades made against it.
+		this.Seller = lastTrade.SellerAddress
+		this.Selling = Asset{
+			Type:   lastTrade.SoldAssetType,
+			Code:   lastTrade.SoldAssetCode,
+			Issuer: lastTrade.SoldAssetIssuer,
+		}
+		this.Buying = Asset{
+			Type:   lastTrade.BoughtAssetType,
+			Code:   lastTrade.BoughtAssetCode,
+			Issuer: lastTrade.BoughtAssetIssuer,
+		}
+	}
+
+	switch {
+	case trades.Count == 0:
+		this.Status = OfferStatusCancelled
+	case last != nil && last.Type == history.EffectOfferRemoved && last.HistoryOperationID == trades.LastOperationID:
+		this.Status = OfferStatusFilled
+		this.Amount = amount.String(0)
+	default:
+		this.Status = OfferStatusPartiallyFilled
+	}
+
+	lb := hal.LinkBuilder{httpx.BaseURL(ctx)}
+	self := fmt.Sprintf("/offers/%d", id)
+	this.Links.Self = lb.Link(self)
+	this.Links.OfferMaker = lb.Linkf("/accounts/%s", this.Seller)
+	this.Links.Trades = lb.PagedLink(self, "trades")
+	return nil
+}
+
+// PagingToken implementation for hal.Pageable
+func (this HistoricalOffer) PagingToken() string {
+	return this.PT
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/stellar/go/support/errors"
 	"github.com/stellar/horizon/db2/history"
 	"github.com/stellar/horizon/render/hal"
+	"github.com/stellar/horizon/render/problem"
 	"github.com/stellar/horizon/resource/base"
 	"github.com/stellar/horizon/resource/effects"
 	"github.com/stellar/horizon/resource/operations"
@@ -66,6 +67,21 @@ type AccountThresholds struct {
 // Asset represents a single asset
 type Asset base.Asset
 
+// AssetStat represents the statistics of a single non-native asset
+type AssetStat struct {
+	Links struct {
+		Issuer hal.Link `json:"issuer"`
+	} `json:"_links"`
+
+	AssetType   string       `json:"asset_type"`
+	AssetCode   string       `json:"asset_code"`
+	AssetIssuer string       `json:"asset_issuer"`
+	PT          string       `json:"paging_token"`
+	Amount      string       `json:"amount"`
+	NumAccounts int32        `json:"num_accounts"`
+	Flags       AccountFlags `json:"flags"`
+}
+
 // Balance represents an account's holdings for a single currency type
 type Balance struct {
 	Balance string `json:"balance"`
@@ -123,6 +139,29 @@ type Offer struct {
 	Price   string `json:"price"`
 }
 
+// HistoricalOffer is the display form of an offer that is no longer present in
+// the ledger, reconstructed from the trades and offer effects recorded in the
+// history database.
+type HistoricalOffer struct {
+	Links struct {
+		Self       hal.Link `json:"self"`
+		OfferMaker hal.Link `json:"offer_maker"`
+		Trades     hal.Link `json:"trades"`
+	} `json:"_links"`
+
+	ID           int64  `json:"id"`
+	PT           string `json:"paging_token"`
+	Seller       string `json:"seller"`
+	Selling      Asset  `json:"selling"`
+	Buying       Asset  `json:"buying"`
+	Amount       string `json:"amount"`
+	PriceR       Price  `json:"price_r"`
+	Price        string `json:"price"`
+	Status       string `json:"status"`
+	AmountSold   string `json:"amount_sold"`
+	AmountBought string `json:"amount_bought"`
+}
+
 // OrderBookSummary represents a snapshot summary of a given order book
 type OrderBookSummary struct {
 	Bids    []PriceLevel `json:"bids"`
@@ -142,6 +181,8 @@ type Path struct {
 	DestinationAssetIssuer string  `json:"destination_asset_issuer,omitempty"`
 	DestinationAmount      string  `json:"destination_amount"`
 	Path                   []Asset `json:"path"`
+	Price                  string  `json:"price,omitempty"`
+	Slippage               string  `json:"slippage,omitempty"`
 }
 
 // Price represents a price
@@ -175,6 +216,7 @@ type Root struct {
 	CoreElderSequence    int32  `json:"core_elder_ledger"`
 	NetworkPassphrase    string `json:"network_passphrase"`
 	ProtocolVersion      int32  `json:"protocol_version"`
+	IngestRole           string `json:"ingest_role,omitempty"`
 }
 
 // Signer represents one of an account's signers.
@@ -185,6 +227,13 @@ type Signer struct {
 	Type      string `json:"type"`
 }
 
+// SubscriptionEvent is a record sent on a multiplexed stream, tagged with the
+// subscription it belongs to.
+type SubscriptionEvent struct {
+	Subscription string      `json:"subscription"`
+	Record       interface{} `json:"record"`
+}
+
 // Trade represents a trade effect
 type Trade struct {
 	Links struct {
@@ -210,7 +259,21 @@ type Trade struct {
 	LedgerCloseTime   time.Time `json:"created_at"`
 }
 
-// Transaction represents a single, successful transaction
+// TradeAggregation represents the trades between two assets that occurred
+// within a single time bucket
+type TradeAggregation struct {
+	Timestamp     int64  `json:"timestamp"`
+	TradeCount    int64  `json:"trade_count"`
+	BaseVolume    string `json:"base_volume"`
+	CounterVolume string `json:"counter_volume"`
+	Average       string `json:"avg"`
+	High          string `json:"high"`
+	Low           string `json:"low"`
+	Open          string `json:"open"`
+	Close         string `json:"close"`
+}
+
+// Transaction represents a single transaction, successful or failed
 type Transaction struct {
 	Links struct {
 		Self       hal.Link `json:"self"`
@@ -239,6 +302,7 @@ type Transaction struct {
 	Signatures      []string  `json:"signatures"`
 	ValidAfter      string    `json:"valid_after,omitempty"`
 	ValidBefore     string    `json:"valid_before,omitempty"`
+	Successful      bool      `json:"successful"`
 }
 
 // TransactionResultCodes represent a summary of result codes returned from
@@ -248,6 +312,54 @@ type TransactionResultCodes struct {
 	OperationCodes  []string `json:"operations,omitempty"`
 }
 
+// TransactionAccepted represents a transaction that stellar-core has accepted
+// but that has not yet been included in a ledger.  It is returned by
+// asynchronous transaction submissions.
+type TransactionAccepted struct {
+	Links struct {
+		Transaction hal.Link `json:"transaction"`
+		Status      hal.Link `json:"status"`
+	} `json:"_links"`
+	Hash   string `json:"hash"`
+	Status string `json:"status"`
+	Env    string `json:"envelope_xdr"`
+}
+
+// TransactionStatus represents how far the submission of a transaction has
+// progressed.
+type TransactionStatus struct {
+	Links struct {
+		Self        hal.Link `json:"self"`
+		Transaction hal.Link `json:"transaction"`
+	} `json:"_links"`
+	Hash        string                  `json:"hash"`
+	Status      string                  `json:"status"`
+	Ledger      int32                   `json:"ledger,omitempty"`
+	Result      string                  `json:"result_xdr,omitempty"`
+	ResultCodes *TransactionResultCodes `json:"result_codes,omitempty"`
+}
+
+// TransactionBatchResult represents the outcome of one of the transactions
+// submitted in a batch.  Transaction is set when the transaction succeeded and
+// Problem when it did not.
+type TransactionBatchResult struct {
+	Index       int                 `json:"index"`
+	Status      string              `json:"status"`
+	Transaction *TransactionSuccess `json:"transaction,omitempty"`
+	Problem     *problem.P          `json:"problem,omitempty"`
+}
+
+// TransactionValidation represents a transaction that passed the checks made
+// before submission.
+type TransactionValidation struct {
+	Links struct {
+		Transaction hal.Link `json:"transaction"`
+	} `json:"_links"`
+	Hash  string `json:"hash"`
+	Valid bool   `json:"valid"`
+	Env   string `json:"envelope_xdr"`
+}
+
 // TransactionSuccess represents the result of a successful transaction
 // submission.
 type TransactionSuccess struct {
@@ -26,6 +26,7 @@ func (this *Base) Populate(
 	this.populateType(row)
 	this.LedgerCloseTime = ledger.ClosedAt
 	this.TransactionHash = row.TransactionHash
+	this.TransactionSuccessful = row.TransactionSuccessful
 
 	lb := hal.LinkBuilder{Base: httpx.BaseURL(ctx)}
 	self := fmt.Sprintf("/operations/%d", row.ID)
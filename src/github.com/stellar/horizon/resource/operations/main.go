@@ -101,13 +101,14 @@ type Base struct {
 		Precedes    hal.Link `json:"precedes"`
 	} `json:"_links"`
 
-	ID              string    `json:"id"`
-	PT              string    `json:"paging_token"`
-	SourceAccount   string    `json:"source_account"`
-	Type            string    `json:"type"`
-	TypeI           int32     `json:"type_i"`
-	LedgerCloseTime time.Time `json:"created_at"`
-	TransactionHash string    `json:"transaction_hash"`
+	ID                    string    `json:"id"`
+	PT                    string    `json:"paging_token"`
+	SourceAccount         string    `json:"source_account"`
+	Type                  string    `json:"type"`
+	TypeI                 int32     `json:"type_i"`
+	LedgerCloseTime       time.Time `json:"created_at"`
+	TransactionHash       string    `json:"transaction_hash"`
+	TransactionSuccessful bool      `json:"transaction_successful"`
 }
 
 // CreateAccount is the json resource representing a single operation whose type
@@ -1,7 +1,10 @@
 package resource
 
 import (
+	"math/big"
+
 	"github.com/stellar/go/amount"
+	"github.com/stellar/go/xdr"
 	"github.com/stellar/horizon/paths"
 	"golang.org/x/net/context"
 )
@@ -16,6 +19,59 @@ func (this *Path) Populate(ctx context.Context, q paths.Query, p paths.Path) (er
 
 	this.SourceAmount = amount.String(cost)
 
+	err = this.populatePrice(p, cost, q.DestinationAmount)
+	if err != nil {
+		return
+	}
+
+	return this.populateAssets(p)
+}
+
+// PopulateStrictSend fills out the resource from a path found by a strict-send
+// search, in which the source amount is fixed by the query.
+func (this *Path) PopulateStrictSend(ctx context.Context, q paths.Query, p paths.Path) (err error) {
+
+	this.SourceAmount = amount.String(q.SourceAmount)
+	received, err := p.Receive(q.SourceAmount)
+	if err != nil {
+		return
+	}
+
+	this.DestinationAmount = amount.String(received)
+
+	err = this.populatePrice(p, q.SourceAmount, received)
+	if err != nil {
+		return
+	}
+
+	return this.populateAssets(p)
+}
+
+// populatePrice sets the price implied by sending `source` to deliver
+// `destination` along the path, and its slippage relative to the path's best
+// price.  Both are left blank when nothing would be delivered.
+func (this *Path) populatePrice(p paths.Path, source, destination xdr.Int64) error {
+	if destination == 0 {
+		return nil
+	}
+
+	best, err := p.BestPrice()
+	if err != nil {
+		return err
+	}
+
+	price := big.NewRat(int64(source), int64(destination))
+	this.Price = price.FloatString(7)
+
+	var slippage big.Rat
+	slippage.Quo(price, best)
+	slippage.Sub(&slippage, big.NewRat(1, 1))
+	this.Slippage = slippage.FloatString(7)
+
+	return nil
+}
+
+func (this *Path) populateAssets(p paths.Path) (err error) {
 	err = p.Source().Extract(
 		&this.SourceAssetType,
 		&this.SourceAssetCode,
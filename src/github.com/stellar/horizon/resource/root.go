@@ -14,6 +14,7 @@ func (res *Root) Populate(
 	hVersion, cVersion string,
 	passphrase string,
 	pVersion int32,
+	ingestRole string,
 ) {
 	res.HorizonSequence = ledgerState.HistoryLatest
 	res.HistoryElderSequence = ledgerState.HistoryElder
@@ -23,6 +24,7 @@ func (res *Root) Populate(
 	res.StellarCoreVersion = cVersion
 	res.NetworkPassphrase = passphrase
 	res.ProtocolVersion = pVersion
+	res.IngestRole = ingestRole
 
 	lb := hal.LinkBuilder{httpx.BaseURL(ctx)}
 	res.Links.Account = lb.Link("/accounts/{account_id}")
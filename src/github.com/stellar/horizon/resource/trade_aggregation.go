@@ -0,0 +1,32 @@
+package resource
+
+import (
+	"strconv"
+
+	"github.com/stellar/go/amount"
+	"github.com/stellar/horizon/db2/history"
+	"golang.org/x/net/context"
+)
+
+// Populate fills out the details of a trade aggregation using a row produced
+// by history.TradeAggregationsQ.
+func (res *TradeAggregation) Populate(
+	ctx context.Context,
+	row history.TradeAggregation,
+) (err error) {
+	res.Timestamp = row.Timestamp
+	res.TradeCount = row.TradeCount
+	res.BaseVolume = amount.String(row.BaseVolume)
+	res.CounterVolume = amount.String(row.CounterVolume)
+	res.Average = row.Average
+	res.High = row.High
+	res.Low = row.Low
+	res.Open = row.Open
+	res.Close = row.Close
+	return
+}
+
+// PagingToken implementation for hal.Pageable
+func (res TradeAggregation) PagingToken() string {
+	return strconv.FormatInt(res.Timestamp, 10)
+}
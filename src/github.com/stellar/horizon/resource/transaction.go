@@ -36,6 +36,7 @@ func (res *Transaction) Populate(
 	res.Signatures = strings.Split(row.SignatureString, ",")
 	res.ValidBefore = res.timeString(row.ValidBefore)
 	res.ValidAfter = res.timeString(row.ValidAfter)
+	res.Successful = row.Successful
 
 	lb := hal.LinkBuilder{Base: httpx.BaseURL(ctx)}
 	res.Links.Account = lb.Link("/accounts", res.Account)
@@ -0,0 +1,20 @@
+package resource
+
+import (
+	"github.com/stellar/horizon/httpx"
+	"github.com/stellar/horizon/render/hal"
+	"github.com/stellar/horizon/txsub"
+	"golang.org/x/net/context"
+)
+
+// Populate fills out the details
+func (res *TransactionAccepted) Populate(ctx context.Context, result txsub.Result) {
+	res.Hash = result.Hash
+	res.Status = string(txsub.TransactionStatusPending)
+	res.Env = result.EnvelopeXDR
+
+	lb := hal.LinkBuilder{httpx.BaseURL(ctx)}
+	res.Links.Transaction = lb.Link("/transactions", result.Hash)
+	res.Links.Status = lb.Link("/transactions", result.Hash, "status")
+	return
+}
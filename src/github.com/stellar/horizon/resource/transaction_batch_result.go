@@ -0,0 +1,32 @@
+package resource
+
+import (
+	"strconv"
+
+	"github.com/stellar/horizon/render/problem"
+	"github.com/stellar/horizon/txsub"
+	"golang.org/x/net/context"
+)
+
+// Populate fills out the details of a successful transaction
+func (res *TransactionBatchResult) Populate(ctx context.Context, index int, result txsub.Result) {
+	res.Index = index
+	res.Status = "success"
+	res.Transaction = &TransactionSuccess{}
+	res.Transaction.Populate(ctx, result)
+}
+
+// PopulateProblem fills out the details of a transaction that could not be
+// submitted or failed, as described by `err`
+func (res *TransactionBatchResult) PopulateProblem(ctx context.Context, index int, err error) {
+	p := problem.FromError(ctx, err)
+
+	res.Index = index
+	res.Status = "failed"
+	res.Problem = &p
+}
+
+// PagingToken implementation for hal.Pageable
+func (res TransactionBatchResult) PagingToken() string {
+	return strconv.Itoa(res.Index)
+}
@@ -22,3 +22,18 @@ func (res *TransactionResultCodes) Populate(ctx context.Context,
 
 	return
 }
+
+// PopulateFromInvalid fills out the details from a transaction that failed
+// validation
+func (res *TransactionResultCodes) PopulateFromInvalid(ctx context.Context,
+	invalid *txsub.InvalidTransactionError,
+) (err error) {
+
+	res.TransactionCode, err = invalid.TransactionResultCode()
+	if err != nil {
+		return
+	}
+
+	res.OperationCodes = invalid.OperationCodes
+	return
+}
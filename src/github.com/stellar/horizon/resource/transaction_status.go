@@ -0,0 +1,41 @@
+package resource
+
+import (
+	"github.com/stellar/horizon/httpx"
+	"github.com/stellar/horizon/render/hal"
+	"github.com/stellar/horizon/txsub"
+	"golang.org/x/net/context"
+)
+
+// Populate fills out the details
+func (res *TransactionStatus) Populate(
+	ctx context.Context,
+	hash string,
+	status txsub.TransactionStatus,
+	result txsub.Result,
+) (err error) {
+	res.Hash = hash
+	res.Status = string(status)
+
+	switch status {
+	case txsub.TransactionStatusSuccess:
+		res.Ledger = result.LedgerSequence
+		res.Result = result.ResultXDR
+	case txsub.TransactionStatusFailed:
+		res.Ledger = result.LedgerSequence
+
+		if fail, ok := result.Err.(*txsub.FailedTransactionError); ok {
+			res.Result = fail.ResultXDR
+			res.ResultCodes = &TransactionResultCodes{}
+			err = res.ResultCodes.Populate(ctx, fail)
+			if err != nil {
+				return
+			}
+		}
+	}
+
+	lb := hal.LinkBuilder{httpx.BaseURL(ctx)}
+	res.Links.Self = lb.Link("/transactions", hash, "status")
+	res.Links.Transaction = lb.Link("/transactions", hash)
+	return
+}
@@ -0,0 +1,18 @@
+package resource
+
+import (
+	"github.com/stellar/horizon/httpx"
+	"github.com/stellar/horizon/render/hal"
+	"golang.org/x/net/context"
+)
+
+// Populate fills out the details
+func (res *TransactionValidation) Populate(ctx context.Context, hash string, env string) {
+	res.Hash = hash
+	res.Valid = true
+	res.Env = env
+
+	lb := hal.LinkBuilder{httpx.BaseURL(ctx)}
+	res.Links.Transaction = lb.Link("/transactions", hash)
+	return
+}
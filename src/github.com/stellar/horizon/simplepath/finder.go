@@ -1,9 +1,7 @@
 package simplepath
 
 import (
-	"github.com/go-errors/errors"
 	"github.com/stellar/horizon/db2/core"
-	"github.com/stellar/horizon/log"
 	"github.com/stellar/horizon/paths"
 )
 
@@ -20,29 +18,11 @@ type Finder struct {
 var _ paths.Finder = &Finder{}
 
 // Find performs a path find with the provided query.
-func (f *Finder) Find(q paths.Query) (result []paths.Path, err error) {
-	log.WithField("source_assets", q.SourceAssets).
-		WithField("destination_asset", q.DestinationAsset).
-		WithField("destination_amount", q.DestinationAmount).
-		Info("Starting pathfind")
-
-	if len(q.SourceAssets) == 0 {
-		err = errors.New("No source assets")
-		return
-	}
-
-	s := &search{
-		Query:  q,
-		Finder: f,
-	}
-
-	s.Init()
-	s.Run()
-
-	result, err = s.Results, s.Err
+func (f *Finder) Find(q paths.Query) ([]paths.Path, error) {
+	return paths.Find(q, &orderBooks{Q: f.Q})
+}
 
-	log.WithField("found", len(s.Results)).
-		WithField("err", s.Err).
-		Info("Finished pathfind")
-	return
+// FindStrictSend performs a strict-send path find with the provided query.
+func (f *Finder) FindStrictSend(q paths.Query) ([]paths.Path, error) {
+	return paths.FindStrictSend(q, &orderBooks{Q: f.Q})
 }
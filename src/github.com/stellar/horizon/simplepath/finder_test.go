@@ -17,28 +17,56 @@ func TestFinder(t *testing.T) {
 		Q: &core.Q{Session: tt.CoreSession()},
 	}
 
-	native := makeAsset(xdr.AssetTypeAssetTypeNative, "", "")
-	usd := makeAsset(
+	native := test.MakeAsset(xdr.AssetTypeAssetTypeNative, "", "")
+	usd := test.MakeAsset(
 		xdr.AssetTypeAssetTypeCreditAlphanum4,
 		"USD",
 		"GDSBCQO34HWPGUGQSP3QBFEXVTSR2PW46UIGTHVWGWJGQKH3AFNHXHXN")
-	eur := makeAsset(
+	eur := test.MakeAsset(
 		xdr.AssetTypeAssetTypeCreditAlphanum4,
 		"EUR",
 		"GDSBCQO34HWPGUGQSP3QBFEXVTSR2PW46UIGTHVWGWJGQKH3AFNHXHXN")
 
 	query := paths.Query{
 		DestinationAddress: "GAEDTJ4PPEFVW5XV2S7LUXBEHNQMX5Q2GM562RJGOQG7GVCE5H3HIB4V",
-		DestinationAsset:   eur,
+		DestinationAssets:  []xdr.Asset{eur},
 		DestinationAmount:  xdr.Int64(200000000),
 		SourceAssets:       []xdr.Asset{usd},
 	}
 
 	p, err := finder.Find(query)
+	if tt.Assert.NoError(err) && tt.Assert.Len(p, 3) {
+		// the direct path is cheapest
+		tt.Assert.Empty(p[0].Path())
+
+		var last xdr.Int64
+		for _, path := range p {
+			cost, err := path.Cost(query.DestinationAmount)
+			tt.Require.NoError(err)
+			tt.Assert.True(cost >= last)
+			last = cost
+		}
+
+		best, err := p[0].BestPrice()
+		if tt.Assert.NoError(err) {
+			tt.Assert.Equal("1/2", best.String())
+		}
+	}
+
+	query.MaxPaths = 1
+	p, err = finder.Find(query)
 	if tt.Assert.NoError(err) {
-		tt.Assert.Len(p, 3)
+		tt.Assert.Len(p, 1)
 	}
 
+	query.MaxPaths = 0
+	query.MaxHops = 2
+	p, err = finder.Find(query)
+	if tt.Assert.NoError(err) {
+		tt.Assert.Len(p, 2)
+	}
+	query.MaxHops = 0
+
 	query.DestinationAmount = xdr.Int64(200000001)
 	p, err = finder.Find(query)
 	if tt.Assert.NoError(err) {
@@ -51,11 +79,19 @@ func TestFinder(t *testing.T) {
 		tt.Assert.Len(p, 0)
 	}
 
+	// each destination asset is searched, and the cheapest path comes first
+	query.DestinationAmount = xdr.Int64(200000000)
+	query.DestinationAssets = []xdr.Asset{eur, native}
+	p, err = finder.Find(query)
+	if tt.Assert.NoError(err) && tt.Assert.Len(p, 4) {
+		tt.Assert.Equal(native, p[0].Destination())
+	}
+
 	//  regression: paths that involve native currencies can be found
 
 	query = paths.Query{
 		DestinationAddress: "GDSBCQO34HWPGUGQSP3QBFEXVTSR2PW46UIGTHVWGWJGQKH3AFNHXHXN",
-		DestinationAsset:   native,
+		DestinationAssets:  []xdr.Asset{native},
 		DestinationAmount:  xdr.Int64(1),
 		SourceAssets:       []xdr.Asset{usd, native},
 	}
@@ -64,3 +100,62 @@ func TestFinder(t *testing.T) {
 		tt.Assert.Len(p, 2)
 	}
 }
+
+func TestFinder_StrictSend(t *testing.T) {
+	tt := test.Start(t).Scenario("paths")
+	defer tt.Finish()
+
+	finder := &Finder{
+		Q: &core.Q{Session: tt.CoreSession()},
+	}
+
+	native := test.MakeAsset(xdr.AssetTypeAssetTypeNative, "", "")
+	usd := test.MakeAsset(
+		xdr.AssetTypeAssetTypeCreditAlphanum4,
+		"USD",
+		"GDSBCQO34HWPGUGQSP3QBFEXVTSR2PW46UIGTHVWGWJGQKH3AFNHXHXN")
+	eur := test.MakeAsset(
+		xdr.AssetTypeAssetTypeCreditAlphanum4,
+		"EUR",
+		"GDSBCQO34HWPGUGQSP3QBFEXVTSR2PW46UIGTHVWGWJGQKH3AFNHXHXN")
+
+	query := paths.Query{
+		SourceAsset:       usd,
+		SourceAmount:      xdr.Int64(200000000),
+		DestinationAssets: []xdr.Asset{eur},
+	}
+
+	p, err := finder.FindStrictSend(query)
+	if tt.Assert.NoError(err) && tt.Assert.Len(p, 4) {
+		// the direct path takes both offers at 0.5 before the offer at 1
+		tt.Assert.Equal(usd, p[0].Source())
+		tt.Assert.Equal(eur, p[0].Destination())
+		tt.Assert.Empty(p[0].Path())
+
+		received, err := p[0].Receive(query.SourceAmount)
+		if tt.Assert.NoError(err) {
+			tt.Assert.Equal(xdr.Int64(300000000), received)
+		}
+	}
+
+	// the direct order book can only absorb 20 USD
+	query.SourceAmount = xdr.Int64(300000000)
+	p, err = finder.FindStrictSend(query)
+	if tt.Assert.NoError(err) {
+		tt.Assert.Len(p, 2)
+	}
+
+	query.DestinationAssets = []xdr.Asset{eur, native}
+	p, err = finder.FindStrictSend(query)
+	if tt.Assert.NoError(err) {
+		tt.Assert.Len(p, 3)
+	}
+
+	// nothing buys native
+	query.SourceAsset = native
+	query.DestinationAssets = []xdr.Asset{eur}
+	p, err = finder.FindStrictSend(query)
+	if tt.Assert.NoError(err) {
+		tt.Assert.Len(p, 0)
+	}
+}
@@ -1,19 +1,58 @@
 package simplepath
 
 import (
-	"errors"
 	"math/big"
 
 	sq "github.com/Masterminds/squirrel"
 	"github.com/stellar/go/xdr"
 	"github.com/stellar/horizon/assets"
 	"github.com/stellar/horizon/db2/core"
+	"github.com/stellar/horizon/paths"
 )
 
-// ErrNotEnough represents an error that occurs when pricing a trade on an
-// orderbook.  This error occurs when the orderbook cannot fulfill the
-// requested amount.
-var ErrNotEnough = errors.New("not enough depth")
+// orderBooks implements the paths.OrderBooks interface, querying the offers
+// table of a stellar-core database for each order book.
+type orderBooks struct {
+	Q *core.Q
+}
+
+// check interface compatibility
+var _ paths.OrderBooks = &orderBooks{}
+
+// ConnectedAssets implements paths.OrderBooks.ConnectedAssets
+func (obs *orderBooks) ConnectedAssets(selling xdr.Asset) (result []xdr.Asset, err error) {
+	err = obs.Q.ConnectedAssets(&result, selling)
+	return
+}
+
+// ConnectedSellingAssets implements paths.OrderBooks.ConnectedSellingAssets
+func (obs *orderBooks) ConnectedSellingAssets(buying xdr.Asset) (result []xdr.Asset, err error) {
+	err = obs.Q.ConnectedSellingAssets(&result, buying)
+	return
+}
+
+// Cost implements paths.OrderBooks.Cost
+func (obs *orderBooks) Cost(selling, buying xdr.Asset, amount xdr.Int64) (xdr.Int64, error) {
+	return obs.book(selling, buying).Cost(selling, amount)
+}
+
+// Receive implements paths.OrderBooks.Receive
+func (obs *orderBooks) Receive(selling, buying xdr.Asset, amount xdr.Int64) (xdr.Int64, error) {
+	return obs.book(selling, buying).Receive(amount)
+}
+
+// BestPrice implements paths.OrderBooks.BestPrice
+func (obs *orderBooks) BestPrice(selling, buying xdr.Asset) (*big.Rat, error) {
+	return obs.book(selling, buying).BestPrice()
+}
+
+func (obs *orderBooks) book(selling, buying xdr.Asset) *orderBook {
+	return &orderBook{
+		Selling: selling,
+		Buying:  buying,
+		Q:       obs.Q,
+	}
+}
 
 type orderBook struct {
 	Selling xdr.Asset
@@ -23,38 +62,11 @@ type orderBook struct {
 
 func (ob *orderBook) Cost(source xdr.Asset, sourceAmount xdr.Int64) (result xdr.Int64, err error) {
 	// load offers from the two assets
-
-	var (
-		// selling/buying types
-		st, bt xdr.AssetType
-		// selling/buying codes
-		sc, bc string
-		// selling/buying issuers
-		si, bi string
-	)
-
-	err = ob.Selling.Extract(&st, &sc, &si)
-	if err != nil {
-		return
-	}
-
-	err = ob.Buying.Extract(&bt, &bc, &bi)
+	sql, err := ob.query("amount", "pricen", "priced", "offerid")
 	if err != nil {
 		return
 	}
 
-	sql := sq.
-		Select("amount", "pricen", "priced", "offerid").
-		From("offers").
-		Where(sq.Eq{
-			"sellingassettype":               st,
-			"COALESCE(sellingassetcode, '')": sc,
-			"COALESCE(sellingissuer, '')":    si}).
-		Where(sq.Eq{
-			"buyingassettype":               bt,
-			"COALESCE(buyingassetcode, '')": bc,
-			"COALESCE(buyingissuer, '')":    bi})
-
 	inverted := assets.Equals(source, ob.Buying)
 
 	if !inverted {
@@ -97,7 +109,118 @@ func (ob *orderBook) Cost(source xdr.Asset, sourceAmount xdr.Int64) (result xdr.
 		needed -= available
 	}
 
-	err = ErrNotEnough
+	err = paths.ErrNotEnough
+	return
+}
+
+// BestPrice returns the price, delimited in the buying asset per the selling
+// asset, of the best offer in the order book.
+func (ob *orderBook) BestPrice() (*big.Rat, error) {
+	sql, err := ob.query("pricen", "priced")
+	if err != nil {
+		return nil, err
+	}
+	sql = sql.OrderBy("price ASC").Limit(1)
+
+	var rows []struct {
+		Pricen int64 `db:"pricen"`
+		Priced int64 `db:"priced"`
+	}
+
+	err = ob.Q.Select(&rows, sql)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(rows) == 0 {
+		return nil, paths.ErrNotEnough
+	}
+
+	return big.NewRat(rows[0].Pricen, rows[0].Priced), nil
+}
+
+// Receive returns the amount of the selling asset received in exchange for
+// `sourceAmount` of the buying asset, taking offers from the best price.
+func (ob *orderBook) Receive(sourceAmount xdr.Int64) (result xdr.Int64, err error) {
+	sql, err := ob.query("amount", "pricen", "priced", "offerid")
+	if err != nil {
+		return
+	}
+	sql = sql.OrderBy("price ASC")
+
+	rows, err := ob.Q.Query(sql)
+	if err != nil {
+		return
+	}
+	defer rows.Close()
+
+	var (
+		remaining = int64(sourceAmount)
+		received  int64
+	)
+
+	for rows.Next() {
+		var amount, pricen, priced, offerid int64
+		err = rows.Scan(&amount, &pricen, &priced, &offerid)
+		if err != nil {
+			return
+		}
+
+		received, remaining = receive(received, remaining, amount, pricen, priced)
+		if remaining == 0 {
+			result = xdr.Int64(received)
+			return
+		}
+	}
+
+	err = paths.ErrNotEnough
+	return
+}
+
+// receive takes as much of an offer as is needed to spend `remaining` of the
+// offer's buying asset, returning the updated amount of the offer's selling
+// asset received and the amount still left to spend.
+func receive(received, remaining, amount, pricen, priced int64) (int64, int64) {
+	capacity := mul(amount, pricen, priced)
+	if capacity >= remaining {
+		return received + mul(remaining, priced, pricen), 0
+	}
+
+	return received + amount, remaining - capacity
+}
+
+// query returns a query selecting `columns` from the offers in the order book.
+func (ob *orderBook) query(columns ...string) (sql sq.SelectBuilder, err error) {
+	var (
+		// selling/buying types
+		st, bt xdr.AssetType
+		// selling/buying codes
+		sc, bc string
+		// selling/buying issuers
+		si, bi string
+	)
+
+	err = ob.Selling.Extract(&st, &sc, &si)
+	if err != nil {
+		return
+	}
+
+	err = ob.Buying.Extract(&bt, &bc, &bi)
+	if err != nil {
+		return
+	}
+
+	sql = sq.
+		Select(columns...).
+		From("offers").
+		Where(sq.Eq{
+			"sellingassettype":               st,
+			"COALESCE(sellingassetcode, '')": sc,
+			"COALESCE(sellingissuer, '')":    si}).
+		Where(sq.Eq{
+			"buyingassettype":               bt,
+			"COALESCE(buyingassetcode, '')": bc,
+			"COALESCE(buyingissuer, '')":    bi})
 	return
 }
 
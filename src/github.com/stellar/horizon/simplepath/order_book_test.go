@@ -13,11 +13,11 @@ func TestOrderBook(t *testing.T) {
 	defer tt.Finish()
 
 	ob := orderBook{
-		Selling: makeAsset(
+		Selling: test.MakeAsset(
 			xdr.AssetTypeAssetTypeCreditAlphanum4,
 			"EUR",
 			"GDSBCQO34HWPGUGQSP3QBFEXVTSR2PW46UIGTHVWGWJGQKH3AFNHXHXN"),
-		Buying: makeAsset(
+		Buying: test.MakeAsset(
 			xdr.AssetTypeAssetTypeCreditAlphanum4,
 			"USD",
 			"GDSBCQO34HWPGUGQSP3QBFEXVTSR2PW46UIGTHVWGWJGQKH3AFNHXHXN"),
@@ -57,11 +57,11 @@ func TestOrderBook_BadCost(t *testing.T) {
 	defer tt.Finish()
 
 	ob := orderBook{
-		Selling: makeAsset(
+		Selling: test.MakeAsset(
 			xdr.AssetTypeAssetTypeCreditAlphanum4,
 			"EUR",
 			"GDSBCQO34HWPGUGQSP3QBFEXVTSR2PW46UIGTHVWGWJGQKH3AFNHXHXN"),
-		Buying: makeAsset(
+		Buying: test.MakeAsset(
 			xdr.AssetTypeAssetTypeCreditAlphanum4,
 			"USD",
 			"GDSBCQO34HWPGUGQSP3QBFEXVTSR2PW46UIGTHVWGWJGQKH3AFNHXHXN"),
@@ -1,11 +1,13 @@
-package simplepath
+package test
 
 import (
 	"github.com/stellar/go/strkey"
 	"github.com/stellar/go/xdr"
 )
 
-func makeAsset(typ xdr.AssetType, code string, issuer string) xdr.Asset {
+// MakeAsset builds an asset of type `typ`.  The code and issuer are ignored
+// for the native asset.
+func MakeAsset(typ xdr.AssetType, code string, issuer string) xdr.Asset {
 
 	if typ == xdr.AssetTypeAssetTypeNative {
 		result, _ := xdr.NewAsset(typ, nil)
@@ -79,3 +79,23 @@ type MalformedTransactionError struct {
 func (err *MalformedTransactionError) Error() string {
 	return "tx malformed"
 }
+
+// InvalidTransactionError represents an error that occurred because a
+// transaction failed the checks made by a Validator, before it was submitted
+// to stellar-core.  Code is the result code stellar-core would have responded
+// with and, when Code is tx_failed, OperationCodes holds the result code of
+// each operation.
+type InvalidTransactionError struct {
+	Code           xdr.TransactionResultCode
+	OperationCodes []string
+	Reason         string
+}
+
+func (err *InvalidTransactionError) Error() string {
+	return fmt.Sprintf("tx invalid: %s", err.Reason)
+}
+
+// TransactionResultCode returns the string form of the error's result code
+func (err *InvalidTransactionError) TransactionResultCode() (string, error) {
+	return codes.String(err.Code)
+}
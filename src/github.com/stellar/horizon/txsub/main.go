@@ -59,6 +59,33 @@ type Submitter interface {
 	Submit(context.Context, string) SubmissionResult
 }
 
+// Validator checks transactions before they are submitted to stellar-core, so
+// that transactions stellar-core would reject fail without a round trip.
+type Validator interface {
+	// Validate returns an *InvalidTransactionError describing why the
+	// provided base64 encoded transaction envelope would be rejected, or nil
+	// when it is expected to be accepted.
+	Validate(context.Context, string) error
+}
+
+// TargetedSubmitter is implemented by submitters that submit to one of several
+// stellar-core instances.  The System tracks submission metrics for each of
+// the instances.
+type TargetedSubmitter interface {
+	Submitter
+
+	// Targets returns the urls of the stellar-core instances that may be
+	// submitted to.
+	Targets() []string
+}
+
+// HealthChecker is implemented by submitters that check the health of the
+// stellar-core instances they submit to.  The System calls CheckHealth in the
+// background on each tick.
+type HealthChecker interface {
+	CheckHealth(ctx context.Context)
+}
+
 // Result represents the response from a ResultProvider.  Given no
 // Err is set, the rest of the struct should be populated appropriately.
 type Result struct {
@@ -85,6 +112,28 @@ type Result struct {
 	ResultMetaXDR string
 }
 
+// TransactionStatus describes how far the submission of a transaction has progressed.
+type TransactionStatus string
+
+const (
+	// TransactionStatusUnknown is reported for transactions that are neither being
+	// submitted nor recorded in the ledger.
+	TransactionStatusUnknown TransactionStatus = "unknown"
+	// TransactionStatusQueued is reported for transactions waiting in the submission queue
+	// for their source account to reach the preceding sequence number, or being
+	// submitted to stellar-core.
+	TransactionStatusQueued TransactionStatus = "queued"
+	// TransactionStatusPending is reported for transactions that stellar-core has
+	// accepted, but that have not yet been included in a ledger.
+	TransactionStatusPending TransactionStatus = "pending"
+	// TransactionStatusSuccess is reported for transactions that were applied
+	// successfully.
+	TransactionStatusSuccess TransactionStatus = "success"
+	// TransactionStatusFailed is reported for transactions that were included in a ledger
+	// but failed.
+	TransactionStatusFailed TransactionStatus = "failed"
+)
+
 // SubmissionResult gets returned in response to a call to Submitter.Submit.
 // It represents a single discrete submission of a transaction envelope to
 // the stellar network.
@@ -97,6 +146,23 @@ type SubmissionResult struct {
 	// Duration records the time it took to submit a transaction
 	// to stellar-core
 	Duration time.Duration
+
+	// Attempts records each submission to a stellar-core instance made by a
+	// TargetedSubmitter, in the order they were made.
+	Attempts []SubmissionAttempt
+}
+
+// SubmissionAttempt represents the submission of a transaction envelope to one
+// of the stellar-core instances of a TargetedSubmitter.
+type SubmissionAttempt struct {
+	// Target is the url of the stellar-core instance submitted to.
+	Target string
+
+	// Err is the error that occurred during the submission, if any.
+	Err error
+
+	// Duration records the time the submission took.
+	Duration time.Duration
 }
 
 func (s SubmissionResult) IsBadSeq() (bool, error) {
@@ -0,0 +1,258 @@
+package txsub
+
+import (
+	"encoding/json"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-errors/errors"
+	"golang.org/x/net/context"
+)
+
+// CoreSyncedState is the state stellar-core reports from its /info endpoint
+// once it is in sync with the network.
+const CoreSyncedState = "Synced!"
+
+// DefaultHealthCheckInterval is how long the health of a stellar-core instance
+// is trusted before its /info endpoint is checked again.
+const DefaultHealthCheckInterval = 5 * time.Second
+
+// DefaultHealthCheckTimeout is how long a stellar-core instance is given to
+// respond to a health check before it is considered unhealthy.
+const DefaultHealthCheckTimeout = 2 * time.Second
+
+// NewMultiSubmitter returns a new Submitter that submits to the first healthy
+// stellar-core of those at `urls`, using the http client `h`.  A stellar-core
+// is healthy when its /info endpoint reports that it is in sync with the
+// network.  When submitting to a stellar-core fails because it could not be
+// reached, the submission is retried against the next one.
+//
+// Health is checked by CheckHealth, which System.Tick calls in the background,
+// so that submissions never wait on a health check.
+func NewMultiSubmitter(h *http.Client, urls []string) TargetedSubmitter {
+	result := &multiSubmitter{
+		http:                h,
+		HealthCheckInterval: DefaultHealthCheckInterval,
+		HealthCheckTimeout:  DefaultHealthCheckTimeout,
+	}
+
+	for _, u := range urls {
+		result.targets = append(result.targets, &submitTarget{
+			url: u,
+			submitter: &submitter{
+				http:    h,
+				coreURL: u,
+			},
+		})
+	}
+
+	return result
+}
+
+// coreInfoResponse is the json response from stellar-core's info endpoint
+type coreInfoResponse struct {
+	Info struct {
+		State string `json:"state"`
+	} `json:"info"`
+}
+
+// multiSubmitter is a TargetedSubmitter that fails over between several
+// stellar-core instances.
+type multiSubmitter struct {
+	http    *http.Client
+	targets []*submitTarget
+
+	HealthCheckInterval time.Duration
+	HealthCheckTimeout  time.Duration
+}
+
+// submitTarget tracks the health of a single stellar-core instance.
+type submitTarget struct {
+	sync.Mutex
+	url       string
+	submitter *submitter
+	healthy   bool
+	checkedAt time.Time
+	checking  bool
+}
+
+// Submit sends the provided envelope to a healthy stellar-core.  When none are
+// healthy, each stellar-core is tried in turn regardless.
+func (sub *multiSubmitter) Submit(ctx context.Context, env string) (result SubmissionResult) {
+	start := time.Now()
+	defer func() { result.Duration = time.Since(start) }()
+
+	var attempts []SubmissionAttempt
+	for _, target := range sub.ordered(ctx) {
+		sr := target.submitter.Submit(ctx, env)
+		attempts = append(attempts, SubmissionAttempt{
+			Target:   target.url,
+			Err:      sr.Err,
+			Duration: sr.Duration,
+		})
+		result = sr
+
+		if !isConnectionError(sr.Err) {
+			break
+		}
+
+		target.markUnhealthy()
+
+		if ctx.Err() != nil {
+			break
+		}
+	}
+
+	if len(attempts) == 0 {
+		result.Err = errors.New("no stellar-core configured for submission")
+	}
+
+	result.Attempts = attempts
+	return
+}
+
+// Targets implements TargetedSubmitter
+func (sub *multiSubmitter) Targets() []string {
+	results := make([]string, len(sub.targets))
+	for i, target := range sub.targets {
+		results[i] = target.url
+	}
+	return results
+}
+
+// CheckHealth implements HealthChecker.  The /info endpoint of every target
+// whose health was last checked over HealthCheckInterval ago is checked
+// concurrently, each check giving up after HealthCheckTimeout.  Targets whose
+// previous check is still in flight are skipped.
+func (sub *multiSubmitter) CheckHealth(ctx context.Context) {
+	var wg sync.WaitGroup
+
+	for _, target := range sub.targets {
+		if !target.startCheck(sub.HealthCheckInterval) {
+			continue
+		}
+
+		wg.Add(1)
+		go func(target *submitTarget) {
+			defer wg.Done()
+
+			ctx, cancel := context.WithTimeout(ctx, sub.HealthCheckTimeout)
+			defer cancel()
+
+			state, err := sub.coreState(ctx, target.url)
+			target.finishCheck(err == nil && state == CoreSyncedState)
+		}(target)
+	}
+
+	wg.Wait()
+}
+
+// ordered returns the targets in the order they should be submitted to:
+// healthy targets first, in the order they were configured, followed by the
+// unhealthy ones.
+func (sub *multiSubmitter) ordered(ctx context.Context) []*submitTarget {
+	healthy := make([]*submitTarget, 0, len(sub.targets))
+	unhealthy := make([]*submitTarget, 0, len(sub.targets))
+
+	for _, target := range sub.targets {
+		if target.isHealthy() {
+			healthy = append(healthy, target)
+		} else {
+			unhealthy = append(unhealthy, target)
+		}
+	}
+
+	return append(healthy, unhealthy...)
+}
+
+// coreState loads the state reported by the /info endpoint of the
+// stellar-core at `coreURL`.
+func (sub *multiSubmitter) coreState(ctx context.Context, coreURL string) (string, error) {
+	u, err := url.Parse(coreURL)
+	if err != nil {
+		return "", errors.Wrap(err, 1)
+	}
+	u.Path = "/info"
+
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return "", errors.Wrap(err, 1)
+	}
+
+	resp, err := sub.http.Do(req.WithContext(ctx))
+	if err != nil {
+		return "", errors.Wrap(err, 1)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return "", errors.Errorf("stellar-core info responded with %s", resp.Status)
+	}
+
+	var info coreInfoResponse
+	err = json.NewDecoder(resp.Body).Decode(&info)
+	if err != nil {
+		return "", errors.Wrap(err, 1)
+	}
+
+	return strings.TrimSpace(info.Info.State), nil
+}
+
+// isHealthy returns whether `target` was healthy when last checked.  A target
+// that has not been checked yet is not healthy.
+func (target *submitTarget) isHealthy() bool {
+	target.Lock()
+	defer target.Unlock()
+	return target.healthy
+}
+
+// startCheck returns whether the health of `target` should be checked,
+// recording that a check is in flight if so.
+func (target *submitTarget) startCheck(interval time.Duration) bool {
+	target.Lock()
+	defer target.Unlock()
+
+	if target.checking || time.Since(target.checkedAt) < interval {
+		return false
+	}
+
+	target.checking = true
+	return true
+}
+
+// finishCheck records the result of a health check started by startCheck.
+func (target *submitTarget) finishCheck(healthy bool) {
+	target.Lock()
+	defer target.Unlock()
+
+	target.healthy = healthy
+	target.checkedAt = time.Now()
+	target.checking = false
+}
+
+func (target *submitTarget) markUnhealthy() {
+	target.Lock()
+	defer target.Unlock()
+
+	target.healthy = false
+	target.checkedAt = time.Now()
+}
+
+// isConnectionError returns true when `err` is the result of failing to reach
+// a stellar-core rather than of stellar-core rejecting the submission.
+func isConnectionError(err error) bool {
+	if wrapped, ok := err.(*errors.Error); ok {
+		err = wrapped.Err
+	}
+
+	switch err.(type) {
+	case *url.Error, net.Error:
+		return true
+	default:
+		return false
+	}
+}
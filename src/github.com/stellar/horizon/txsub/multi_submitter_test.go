@@ -0,0 +1,191 @@
+package txsub
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	. "github.com/smartystreets/goconvey/convey"
+	"github.com/stellar/horizon/test"
+)
+
+// mockCore is a stellar-core stand in that reports `state` from its /info
+// endpoint, after `infoDelay` and with `infoStatus`, responds to submissions
+// with `txResponse` and counts them.
+type mockCore struct {
+	*httptest.Server
+	state       string
+	infoStatus  int
+	infoDelay   time.Duration
+	txResponse  string
+	submissions int
+}
+
+func newMockCore(state string) *mockCore {
+	core := &mockCore{
+		state:      state,
+		infoStatus: http.StatusOK,
+		txResponse: `{"status": "PENDING", "error": null}`,
+	}
+	core.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/info":
+			time.Sleep(core.infoDelay)
+			w.WriteHeader(core.infoStatus)
+			fmt.Fprintf(w, `{"info": {"state": %q}}`, core.state)
+		case "/tx":
+			core.submissions++
+			fmt.Fprintln(w, core.txResponse)
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	return core
+}
+
+func TestMultiSubmitter(t *testing.T) {
+	ctx := test.Context()
+
+	Convey("multiSubmitter", t, func() {
+		synced := newMockCore(CoreSyncedState)
+		defer synced.Close()
+		catchingUp := newMockCore("Catching up")
+		defer catchingUp.Close()
+		unreachable := "http://127.0.0.1:65535"
+
+		Convey("submits to the first synced stellar-core", func() {
+			s := NewMultiSubmitter(http.DefaultClient, []string{catchingUp.URL, synced.URL})
+			s.(HealthChecker).CheckHealth(ctx)
+			sr := s.Submit(ctx, "hello")
+
+			So(sr.Err, ShouldBeNil)
+			So(sr.Duration, ShouldBeGreaterThan, 0)
+			So(synced.submissions, ShouldEqual, 1)
+			So(catchingUp.submissions, ShouldEqual, 0)
+			So(len(sr.Attempts), ShouldEqual, 1)
+			So(sr.Attempts[0].Target, ShouldEqual, synced.URL)
+		})
+
+		Convey("fails over when a stellar-core cannot be reached", func() {
+			s := NewMultiSubmitter(http.DefaultClient, []string{unreachable, synced.URL})
+			ms := s.(*multiSubmitter)
+			ms.CheckHealth(ctx)
+
+			// pretend the unreachable stellar-core was healthy when last checked
+			ms.targets[0].healthy = true
+			ms.targets[0].checkedAt = time.Now()
+
+			sr := s.Submit(ctx, "hello")
+			So(sr.Err, ShouldBeNil)
+			So(synced.submissions, ShouldEqual, 1)
+			So(len(sr.Attempts), ShouldEqual, 2)
+			So(sr.Attempts[0].Target, ShouldEqual, unreachable)
+			So(sr.Attempts[0].Err, ShouldNotBeNil)
+			So(sr.Attempts[1].Target, ShouldEqual, synced.URL)
+			So(sr.Attempts[1].Err, ShouldBeNil)
+
+			Convey("and stops preferring it", func() {
+				sr := s.Submit(ctx, "hello")
+				So(sr.Err, ShouldBeNil)
+				So(len(sr.Attempts), ShouldEqual, 1)
+				So(sr.Attempts[0].Target, ShouldEqual, synced.URL)
+			})
+		})
+
+		Convey("rechecks the health of a stellar-core after the interval", func() {
+			s := NewMultiSubmitter(http.DefaultClient, []string{catchingUp.URL, synced.URL})
+			s.(*multiSubmitter).HealthCheckInterval = 10 * time.Millisecond
+
+			s.(HealthChecker).CheckHealth(ctx)
+			s.Submit(ctx, "hello")
+			So(catchingUp.submissions, ShouldEqual, 0)
+
+			catchingUp.state = CoreSyncedState
+			s.(HealthChecker).CheckHealth(ctx)
+			s.Submit(ctx, "hello")
+			So(catchingUp.submissions, ShouldEqual, 0)
+
+			<-time.After(20 * time.Millisecond)
+
+			s.(HealthChecker).CheckHealth(ctx)
+			s.Submit(ctx, "hello")
+			So(catchingUp.submissions, ShouldEqual, 1)
+		})
+
+		Convey("treats a stellar-core that is slow to respond as unhealthy", func() {
+			slow := newMockCore(CoreSyncedState)
+			slow.infoDelay = 200 * time.Millisecond
+			defer slow.Close()
+
+			s := NewMultiSubmitter(http.DefaultClient, []string{slow.URL, synced.URL})
+			s.(*multiSubmitter).HealthCheckTimeout = 10 * time.Millisecond
+
+			start := time.Now()
+			s.(HealthChecker).CheckHealth(ctx)
+			So(time.Since(start), ShouldBeLessThan, slow.infoDelay)
+
+			s.Submit(ctx, "hello")
+			So(slow.submissions, ShouldEqual, 0)
+			So(synced.submissions, ShouldEqual, 1)
+		})
+
+		Convey("treats an error response from /info as unhealthy", func() {
+			failing := newMockCore(CoreSyncedState)
+			failing.infoStatus = http.StatusInternalServerError
+			defer failing.Close()
+
+			s := NewMultiSubmitter(http.DefaultClient, []string{failing.URL, synced.URL})
+			s.(HealthChecker).CheckHealth(ctx)
+
+			s.Submit(ctx, "hello")
+			So(failing.submissions, ShouldEqual, 0)
+			So(synced.submissions, ShouldEqual, 1)
+		})
+
+		Convey("submits in the configured order before health is checked", func() {
+			s := NewMultiSubmitter(http.DefaultClient, []string{catchingUp.URL, synced.URL})
+			sr := s.Submit(ctx, "hello")
+
+			So(sr.Err, ShouldBeNil)
+			So(catchingUp.submissions, ShouldEqual, 1)
+			So(synced.submissions, ShouldEqual, 0)
+		})
+
+		Convey("submits to an unsynced stellar-core when no other is available", func() {
+			s := NewMultiSubmitter(http.DefaultClient, []string{unreachable, catchingUp.URL})
+			s.(HealthChecker).CheckHealth(ctx)
+			sr := s.Submit(ctx, "hello")
+
+			So(sr.Err, ShouldBeNil)
+			So(catchingUp.submissions, ShouldEqual, 1)
+		})
+
+		Convey("errors when no stellar-core can be reached", func() {
+			s := NewMultiSubmitter(http.DefaultClient, []string{unreachable})
+			sr := s.Submit(ctx, "hello")
+
+			So(sr.Err, ShouldNotBeNil)
+			So(len(sr.Attempts), ShouldEqual, 1)
+		})
+
+		Convey("does not fail over when stellar-core rejects the transaction", func() {
+			rejecting := newMockCore(CoreSyncedState)
+			rejecting.txResponse = `{"status": "ERROR", "error": "AAAAAAAAAAD////7AAAAAA=="}`
+			defer rejecting.Close()
+
+			s := NewMultiSubmitter(http.DefaultClient, []string{rejecting.URL, synced.URL})
+			s.(HealthChecker).CheckHealth(ctx)
+			sr := s.Submit(ctx, "hello")
+
+			So(sr.Err, ShouldHaveSameTypeAs, &FailedTransactionError{})
+			So(synced.submissions, ShouldEqual, 0)
+		})
+
+		Convey("reports its targets", func() {
+			s := NewMultiSubmitter(http.DefaultClient, []string{catchingUp.URL, synced.URL})
+			So(s.Targets(), ShouldResemble, []string{catchingUp.URL, synced.URL})
+		})
+	})
+}
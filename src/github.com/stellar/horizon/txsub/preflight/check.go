@@ -0,0 +1,259 @@
+package preflight
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/stellar/go/keypair"
+	"github.com/stellar/go/strkey"
+	"github.com/stellar/go/xdr"
+	"github.com/stellar/horizon/codes"
+	"github.com/stellar/horizon/db2/core"
+	"github.com/stellar/horizon/txsub"
+)
+
+// Account is an account loaded from stellar-core along with its signers
+type Account struct {
+	core.Account
+	Signers []core.Signer
+}
+
+// State is the ledger state a transaction is checked against
+type State struct {
+	// Accounts holds the source accounts of the transaction and its
+	// operations, keyed by address.  Accounts that do not exist are absent.
+	Accounts map[string]Account
+
+	// BaseFee is the fee per operation, in stroops, of the latest ledger.
+	BaseFee xdr.Uint32
+
+	// Now is the time the transaction's time bounds are checked against.
+	Now time.Time
+}
+
+// Check returns an *txsub.InvalidTransactionError when `tx`, whose hash is
+// `hash`, would be rejected by stellar-core given `state`.  It checks the
+// transaction's operation count, time bounds, fee, source account and
+// sequence number and that its signatures meet the thresholds of every source
+// account involved.
+func Check(tx xdr.TransactionEnvelope, hash [32]byte, state State) error {
+	invalid := func(code xdr.TransactionResultCode, format string, args ...interface{}) error {
+		return &txsub.InvalidTransactionError{
+			Code:   code,
+			Reason: fmt.Sprintf(format, args...),
+		}
+	}
+
+	if len(tx.Tx.Operations) == 0 {
+		return invalid(xdr.TransactionResultCodeTxMissingOperation,
+			"the transaction has no operations")
+	}
+
+	if tb := tx.Tx.TimeBounds; tb != nil {
+		now := uint64(state.Now.Unix())
+
+		if tb.MinTime != 0 && now < uint64(tb.MinTime) {
+			return invalid(xdr.TransactionResultCodeTxTooEarly,
+				"the transaction is not valid before %d", tb.MinTime)
+		}
+
+		if tb.MaxTime != 0 && now > uint64(tb.MaxTime) {
+			return invalid(xdr.TransactionResultCodeTxTooLate,
+				"the transaction is not valid after %d", tb.MaxTime)
+		}
+	}
+
+	minFee := uint64(state.BaseFee) * uint64(len(tx.Tx.Operations))
+	if uint64(tx.Tx.Fee) < minFee {
+		return invalid(xdr.TransactionResultCodeTxInsufficientFee,
+			"the fee of %d stroops is less than the minimum of %d stroops", tx.Tx.Fee, minFee)
+	}
+
+	sourceAddress := address(tx.Tx.SourceAccount)
+	source, ok := state.Accounts[sourceAddress]
+	if !ok {
+		return invalid(xdr.TransactionResultCodeTxNoAccount,
+			"the source account %s does not exist", sourceAddress)
+	}
+
+	seq, err := strconv.ParseUint(source.Seqnum, 10, 64)
+	if err != nil {
+		return err
+	}
+
+	if uint64(tx.Tx.SeqNum) <= seq {
+		return invalid(xdr.TransactionResultCodeTxBadSeq,
+			"the sequence number %d has already been used; the source account's sequence number is %d", tx.Tx.SeqNum, seq)
+	}
+
+	sc := &signatureChecker{
+		hash:       hash,
+		signatures: tx.Signatures,
+		used:       make([]bool, len(tx.Signatures)),
+	}
+
+	if !sc.check(source, thresholdLow) {
+		return invalid(xdr.TransactionResultCodeTxBadAuth,
+			"the signatures do not meet the low threshold of the source account %s", sourceAddress)
+	}
+
+	failed := false
+	reason := ""
+	opCodes := make([]string, len(tx.Tx.Operations))
+	for i, op := range tx.Tx.Operations {
+		opCodes[i] = codes.OpSuccess
+
+		opAddress := sourceAddress
+		if op.SourceAccount != nil {
+			opAddress = address(*op.SourceAccount)
+		}
+
+		account, ok := state.Accounts[opAddress]
+		if !ok {
+			opCodes[i], _ = codes.String(xdr.OperationResultCodeOpNoAccount)
+			failed = true
+			reason = fmt.Sprintf("the source account %s of operation %d does not exist", opAddress, i)
+			continue
+		}
+
+		if !sc.check(account, threshold(op)) {
+			opCodes[i], _ = codes.String(xdr.OperationResultCodeOpBadAuth)
+			failed = true
+			reason = fmt.Sprintf("the signatures do not meet the threshold required of the source account %s by operation %d", opAddress, i)
+		}
+	}
+
+	if failed {
+		return &txsub.InvalidTransactionError{
+			Code:           xdr.TransactionResultCodeTxFailed,
+			OperationCodes: opCodes,
+			Reason:         reason,
+		}
+	}
+
+	for i, used := range sc.used {
+		if !used {
+			return invalid(xdr.TransactionResultCodeTxBadAuthExtra,
+				"signature %d is not needed by any source account", i)
+		}
+	}
+
+	return nil
+}
+
+// thresholdLevel identifies one of an account's thresholds, by its index in
+// xdr.Thresholds
+type thresholdLevel int
+
+const (
+	thresholdLow    thresholdLevel = 1
+	thresholdMedium thresholdLevel = 2
+	thresholdHigh   thresholdLevel = 3
+)
+
+// threshold returns the threshold of its source account that `op` requires.
+func threshold(op xdr.Operation) thresholdLevel {
+	switch op.Body.Type {
+	case xdr.OperationTypeAllowTrust, xdr.OperationTypeInflation:
+		return thresholdLow
+	case xdr.OperationTypeAccountMerge:
+		return thresholdHigh
+	case xdr.OperationTypeSetOptions:
+		so := op.Body.MustSetOptionsOp()
+		if so.MasterWeight != nil ||
+			so.LowThreshold != nil ||
+			so.MedThreshold != nil ||
+			so.HighThreshold != nil ||
+			so.Signer != nil {
+			return thresholdHigh
+		}
+		return thresholdMedium
+	default:
+		return thresholdMedium
+	}
+}
+
+// signatureChecker tracks which of a transaction's signatures have been used
+// to meet the thresholds of its source accounts.
+type signatureChecker struct {
+	hash       [32]byte
+	signatures []xdr.DecoratedSignature
+	used       []bool
+}
+
+// check returns true when the signers of `account` that signed the
+// transaction have enough weight to meet the threshold at `level`.
+func (sc *signatureChecker) check(account Account, level thresholdLevel) bool {
+	signers := make([]core.Signer, 0, len(account.Signers)+1)
+	if master := account.Thresholds[0]; master > 0 {
+		signers = append(signers, core.Signer{
+			Accountid: account.Accountid,
+			Publickey: account.Accountid,
+			Weight:    int32(master),
+		})
+	}
+	signers = append(signers, account.Signers...)
+
+	signed := false
+	weight := int32(0)
+	for _, signer := range signers {
+		if signer.Weight <= 0 || !sc.signedBy(signer.Publickey) {
+			continue
+		}
+
+		signed = true
+		weight += signer.Weight
+		if weight > 255 {
+			weight = 255
+		}
+	}
+
+	return signed && weight >= int32(account.Thresholds[level])
+}
+
+// signedBy returns true when the transaction is signed by the signer with
+// key `key`, which may be an account id, a pre-authorized transaction hash or
+// a hash(x) signer.  Any signatures used are marked as such.
+func (sc *signatureChecker) signedBy(key string) bool {
+	if raw, err := strkey.Decode(strkey.VersionByteHashTx, key); err == nil {
+		return bytes.Equal(raw, sc.hash[:])
+	}
+
+	if raw, err := strkey.Decode(strkey.VersionByteHashX, key); err == nil {
+		return sc.use(raw, func(sig []byte) bool {
+			x := sha256.Sum256(sig)
+			return bytes.Equal(x[:], raw)
+		})
+	}
+
+	kp, err := keypair.Parse(key)
+	if err != nil {
+		return false
+	}
+
+	raw := strkey.MustDecode(strkey.VersionByteAccountID, key)
+	return sc.use(raw, func(sig []byte) bool {
+		return kp.Verify(sc.hash[:], sig) == nil
+	})
+}
+
+// use marks every signature whose hint matches `key` and that satisfies
+// `valid` as used, returning true if any did.
+func (sc *signatureChecker) use(key []byte, valid func([]byte) bool) bool {
+	found := false
+	hint := key[len(key)-4:]
+
+	for i, sig := range sc.signatures {
+		if !bytes.Equal(sig.Hint[:], hint) || !valid(sig.Signature) {
+			continue
+		}
+
+		sc.used[i] = true
+		found = true
+	}
+
+	return found
+}
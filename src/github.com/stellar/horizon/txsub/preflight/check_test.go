@@ -0,0 +1,223 @@
+package preflight
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stellar/go/build"
+	"github.com/stellar/go/keypair"
+	"github.com/stellar/go/strkey"
+	"github.com/stellar/go/xdr"
+	"github.com/stellar/horizon/db2/core"
+	"github.com/stellar/horizon/txsub"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCheck(t *testing.T) {
+	source, err := keypair.Random()
+	require.NoError(t, err)
+	cosigner, err := keypair.Random()
+	require.NoError(t, err)
+	other, err := keypair.Random()
+	require.NoError(t, err)
+
+	now := time.Unix(1500000000, 0)
+
+	// state returns a ledger state in which the source account has a master
+	// weight of 1, thresholds of 0/2/2 and `cosigner` as a signer of weight 1.
+	state := func() State {
+		return State{
+			BaseFee: 100,
+			Now:     now,
+			Accounts: map[string]Account{
+				source.Address(): {
+					Account: core.Account{
+						Accountid:  source.Address(),
+						Seqnum:     "10",
+						Thresholds: xdr.Thresholds{1, 0, 2, 2},
+					},
+					Signers: []core.Signer{
+						{Accountid: source.Address(), Publickey: cosigner.Address(), Weight: 1},
+					},
+				},
+			},
+		}
+	}
+
+	inflation := xdr.Operation{Body: xdr.OperationBody{Type: xdr.OperationTypeInflation}}
+	manageData := xdr.Operation{Body: xdr.OperationBody{
+		Type:         xdr.OperationTypeManageData,
+		ManageDataOp: &xdr.ManageDataOp{DataName: "name"},
+	}}
+
+	cases := []struct {
+		name    string
+		mutate  func(*xdr.Transaction, *State)
+		ops     []xdr.Operation
+		signers []*keypair.Full
+		code    xdr.TransactionResultCode
+		opCodes []string
+	}{
+		{
+			name:    "valid low threshold transaction",
+			ops:     []xdr.Operation{inflation},
+			signers: []*keypair.Full{source},
+		},
+		{
+			name:    "valid medium threshold transaction",
+			ops:     []xdr.Operation{manageData},
+			signers: []*keypair.Full{source, cosigner},
+		},
+		{
+			name:    "no operations",
+			signers: []*keypair.Full{source},
+			code:    xdr.TransactionResultCodeTxMissingOperation,
+		},
+		{
+			name: "too early",
+			mutate: func(tx *xdr.Transaction, s *State) {
+				tx.TimeBounds = &xdr.TimeBounds{MinTime: xdr.Uint64(now.Unix() + 10)}
+			},
+			ops:     []xdr.Operation{inflation},
+			signers: []*keypair.Full{source},
+			code:    xdr.TransactionResultCodeTxTooEarly,
+		},
+		{
+			name: "too late",
+			mutate: func(tx *xdr.Transaction, s *State) {
+				tx.TimeBounds = &xdr.TimeBounds{MaxTime: xdr.Uint64(now.Unix() - 10)}
+			},
+			ops:     []xdr.Operation{inflation},
+			signers: []*keypair.Full{source},
+			code:    xdr.TransactionResultCodeTxTooLate,
+		},
+		{
+			name: "insufficient fee",
+			mutate: func(tx *xdr.Transaction, s *State) {
+				tx.Fee = 150
+			},
+			ops:     []xdr.Operation{inflation, inflation},
+			signers: []*keypair.Full{source},
+			code:    xdr.TransactionResultCodeTxInsufficientFee,
+		},
+		{
+			name: "missing source account",
+			mutate: func(tx *xdr.Transaction, s *State) {
+				delete(s.Accounts, source.Address())
+			},
+			ops:     []xdr.Operation{inflation},
+			signers: []*keypair.Full{source},
+			code:    xdr.TransactionResultCodeTxNoAccount,
+		},
+		{
+			name: "used sequence number",
+			mutate: func(tx *xdr.Transaction, s *State) {
+				tx.SeqNum = 10
+			},
+			ops:     []xdr.Operation{inflation},
+			signers: []*keypair.Full{source},
+			code:    xdr.TransactionResultCodeTxBadSeq,
+		},
+		{
+			name:    "unsigned",
+			ops:     []xdr.Operation{inflation},
+			signers: []*keypair.Full{},
+			code:    xdr.TransactionResultCodeTxBadAuth,
+		},
+		{
+			name:    "signed by an unrelated key",
+			ops:     []xdr.Operation{inflation},
+			signers: []*keypair.Full{other},
+			code:    xdr.TransactionResultCodeTxBadAuth,
+		},
+		{
+			name:    "operation threshold not met",
+			ops:     []xdr.Operation{inflation, manageData},
+			signers: []*keypair.Full{source},
+			code:    xdr.TransactionResultCodeTxFailed,
+			opCodes: []string{"op_success", "op_bad_auth"},
+		},
+		{
+			name: "missing operation source account",
+			mutate: func(tx *xdr.Transaction, s *State) {
+				var aid xdr.AccountId
+				aid.SetAddress(other.Address())
+				tx.Operations[0].SourceAccount = &aid
+			},
+			ops:     []xdr.Operation{inflation},
+			signers: []*keypair.Full{source},
+			code:    xdr.TransactionResultCodeTxFailed,
+			opCodes: []string{"op_no_source_account"},
+		},
+		{
+			name:    "extra signature",
+			ops:     []xdr.Operation{inflation},
+			signers: []*keypair.Full{source, other},
+			code:    xdr.TransactionResultCodeTxBadAuthExtra,
+		},
+		{
+			name: "pre-authorized transaction",
+			mutate: func(tx *xdr.Transaction, s *State) {
+				hash := hashTx(t, *tx)
+				account := s.Accounts[source.Address()]
+				account.Signers = append(account.Signers, core.Signer{
+					Accountid: source.Address(),
+					Publickey: strkey.MustEncode(strkey.VersionByteHashTx, hash[:]),
+					Weight:    1,
+				})
+				s.Accounts[source.Address()] = account
+			},
+			ops:     []xdr.Operation{manageData},
+			signers: []*keypair.Full{source},
+		},
+	}
+
+	for _, c := range cases {
+		var aid xdr.AccountId
+		require.NoError(t, aid.SetAddress(source.Address()))
+
+		// copy the operations, so that mutations don't leak between cases
+		ops := append([]xdr.Operation{}, c.ops...)
+		tx := xdr.Transaction{
+			SourceAccount: aid,
+			Fee:           xdr.Uint32(100 * len(ops)),
+			SeqNum:        11,
+			Operations:    ops,
+		}
+
+		s := state()
+		if c.mutate != nil {
+			c.mutate(&tx, &s)
+		}
+
+		hash := hashTx(t, tx)
+		env := xdr.TransactionEnvelope{Tx: tx}
+		for _, kp := range c.signers {
+			sig, err := kp.SignDecorated(hash[:])
+			require.NoError(t, err)
+			env.Signatures = append(env.Signatures, sig)
+		}
+
+		err := Check(env, hash, s)
+		if c.code == xdr.TransactionResultCodeTxSuccess {
+			assert.NoError(t, err, c.name)
+			continue
+		}
+
+		if assert.IsType(t, &txsub.InvalidTransactionError{}, err, c.name) {
+			invalid := err.(*txsub.InvalidTransactionError)
+			assert.Equal(t, c.code, invalid.Code, c.name)
+			assert.Equal(t, c.opCodes, invalid.OperationCodes, c.name)
+			assert.NotEmpty(t, invalid.Reason, c.name)
+		}
+	}
+}
+
+func hashTx(t *testing.T, tx xdr.Transaction) [32]byte {
+	txb := build.TransactionBuilder{TX: &tx}
+	txb.Mutate(build.TestNetwork)
+	hash, err := txb.Hash()
+	require.NoError(t, err)
+	return hash
+}
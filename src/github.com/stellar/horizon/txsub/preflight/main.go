@@ -0,0 +1,111 @@
+// Package preflight provides an implementation of the txsub.Validator
+// interface that checks transactions against the ledger state recorded in the
+// stellar-core database.
+package preflight
+
+import (
+	"time"
+
+	"github.com/go-errors/errors"
+	"github.com/stellar/go/build"
+	"github.com/stellar/go/strkey"
+	"github.com/stellar/go/xdr"
+	"github.com/stellar/horizon/db2/core"
+	"github.com/stellar/horizon/txsub"
+	"golang.org/x/net/context"
+)
+
+// Validator checks transactions against the accounts and the latest ledger
+// header in the connected stellar-core database.
+type Validator struct {
+	Core              *core.Q
+	NetworkPassphrase string
+}
+
+// Validate implements txsub.Validator
+func (v *Validator) Validate(ctx context.Context, env string) error {
+	var tx xdr.TransactionEnvelope
+	err := xdr.SafeUnmarshalBase64(env, &tx)
+	if err != nil {
+		return &txsub.MalformedTransactionError{EnvelopeXDR: env}
+	}
+
+	txb := build.TransactionBuilder{TX: &tx.Tx}
+	txb.Mutate(build.Network{Passphrase: v.NetworkPassphrase})
+	hash, err := txb.Hash()
+	if err != nil {
+		return errors.Wrap(err, 1)
+	}
+
+	state, err := v.loadState(tx)
+	if err != nil {
+		return err
+	}
+
+	return Check(tx, hash, state)
+}
+
+// loadState loads the accounts involved in `tx` and the latest ledger header
+// from stellar-core.
+func (v *Validator) loadState(tx xdr.TransactionEnvelope) (State, error) {
+	state := State{
+		Accounts: map[string]Account{},
+		Now:      time.Now(),
+	}
+
+	var latest int32
+	err := v.Core.LatestLedger(&latest)
+	if err != nil {
+		return state, errors.Wrap(err, 1)
+	}
+
+	var header core.LedgerHeader
+	err = v.Core.LedgerHeaderBySequence(&header, latest)
+	if err != nil {
+		return state, errors.Wrap(err, 1)
+	}
+	state.BaseFee = header.Data.BaseFee
+
+	for _, address := range sourceAddresses(tx) {
+		if _, loaded := state.Accounts[address]; loaded {
+			continue
+		}
+
+		var account Account
+		err = v.Core.AccountByAddress(&account.Account, address)
+		if v.Core.NoRows(err) {
+			continue
+		}
+		if err != nil {
+			return state, errors.Wrap(err, 1)
+		}
+
+		err = v.Core.SignersByAddress(&account.Signers, address)
+		if err != nil {
+			return state, errors.Wrap(err, 1)
+		}
+
+		state.Accounts[address] = account
+	}
+
+	return state, nil
+}
+
+// sourceAddresses returns the addresses of the source accounts of `tx` and of
+// each of its operations.
+func sourceAddresses(tx xdr.TransactionEnvelope) []string {
+	results := []string{address(tx.Tx.SourceAccount)}
+
+	for _, op := range tx.Tx.Operations {
+		if op.SourceAccount != nil {
+			results = append(results, address(*op.SourceAccount))
+		}
+	}
+
+	return results
+}
+
+func address(aid xdr.AccountId) string {
+	key := aid.MustEd25519()
+	return strkey.MustEncode(strkey.VersionByteAccountID, key[:])
+}
@@ -0,0 +1,130 @@
+package txsub
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/garyburd/redigo/redis"
+	"github.com/go-errors/errors"
+	"github.com/stellar/horizon/log"
+	"golang.org/x/net/context"
+)
+
+// RedisSubmissionListKey is the redis hash in which open submissions are
+// recorded, mapping each transaction hash to the time (in unix seconds) it
+// was first submitted.
+const RedisSubmissionListKey = "txsub:open_submissions"
+
+// NewRedisSubmissionList returns a list that records open submissions in redis,
+// so that they are shared between every horizon instance that uses the same
+// redis server.  Listeners cannot leave the process that added them, so each
+// instance finishes the listeners that were added to it while any instance may
+// finish the submission itself.
+func NewRedisSubmissionList(pool *redis.Pool) OpenSubmissionList {
+	return &redisSubmissionList{
+		pool: pool,
+		local: &submissionList{
+			submissions: map[string]*openSubmission{},
+		},
+	}
+}
+
+type redisSubmissionList struct {
+	pool  *redis.Pool
+	local *submissionList
+}
+
+func (s *redisSubmissionList) Add(ctx context.Context, hash string, l Listener) error {
+	err := s.local.Add(ctx, hash, l)
+	if err != nil {
+		return err
+	}
+
+	c := s.pool.Get()
+	defer c.Close()
+
+	now := strconv.FormatInt(time.Now().Unix(), 10)
+	_, err = c.Do("HSETNX", RedisSubmissionListKey, hash, now)
+	if err != nil {
+		return errors.Wrap(err, 1)
+	}
+
+	return nil
+}
+
+func (s *redisSubmissionList) Finish(ctx context.Context, r Result) error {
+	err := s.local.Finish(ctx, r)
+	if err != nil {
+		return err
+	}
+
+	c := s.pool.Get()
+	defer c.Close()
+
+	_, err = c.Do("HDEL", RedisSubmissionListKey, r.Hash)
+	if err != nil {
+		return errors.Wrap(err, 1)
+	}
+
+	return nil
+}
+
+func (s *redisSubmissionList) Clean(ctx context.Context, maxAge time.Duration) (int, error) {
+	_, err := s.local.Clean(ctx, maxAge)
+	if err != nil {
+		return 0, err
+	}
+
+	c := s.pool.Get()
+	defer c.Close()
+
+	submissions, err := redis.StringMap(c.Do("HGETALL", RedisSubmissionListKey))
+	if err != nil {
+		return 0, errors.Wrap(err, 1)
+	}
+
+	stillOpen := len(submissions)
+	for hash, submittedAt := range submissions {
+		unix, err := strconv.ParseInt(submittedAt, 10, 64)
+		if err == nil && time.Since(time.Unix(unix, 0)) <= maxAge {
+			continue
+		}
+
+		_, err = c.Do("HDEL", RedisSubmissionListKey, hash)
+		if err != nil {
+			return 0, errors.Wrap(err, 1)
+		}
+		stillOpen--
+	}
+
+	return stillOpen, nil
+}
+
+// Pending returns the hashes of the submissions open on any instance, along
+// with those of this instance's listeners.  When redis cannot be reached, only
+// the latter are returned.
+func (s *redisSubmissionList) Pending(ctx context.Context) []string {
+	results := s.local.Pending(ctx)
+
+	c := s.pool.Get()
+	defer c.Close()
+
+	hashes, err := redis.Strings(c.Do("HKEYS", RedisSubmissionListKey))
+	if err != nil {
+		log.Ctx(ctx).WithStack(err).Error(err)
+		return results
+	}
+
+	seen := make(map[string]bool, len(results))
+	for _, hash := range results {
+		seen[hash] = true
+	}
+
+	for _, hash := range hashes {
+		if !seen[hash] {
+			results = append(results, hash)
+		}
+	}
+
+	return results
+}
@@ -0,0 +1,103 @@
+package txsub
+
+import (
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/garyburd/redigo/redis"
+	. "github.com/smartystreets/goconvey/convey"
+	"github.com/stellar/horizon/test"
+)
+
+func TestRedisSubmissionList(t *testing.T) {
+	ctx := test.Context()
+
+	Convey("redisSubmissionList", t, func() {
+		pool := &redis.Pool{
+			Dial: func() (redis.Conn, error) {
+				return redis.Dial("tcp", "127.0.0.1:6379")
+			},
+		}
+		defer pool.Close()
+
+		conn := pool.Get()
+		defer conn.Close()
+		_, err := conn.Do("FLUSHDB")
+		So(err, ShouldBeNil)
+
+		// two lists sharing a redis server, as two horizon instances would
+		list := NewRedisSubmissionList(pool)
+		other := NewRedisSubmissionList(pool)
+
+		hashes := []string{
+			"0000000000000000000000000000000000000000000000000000000000000000",
+			"0000000000000000000000000000000000000000000000000000000000000001",
+		}
+
+		listeners := []chan Result{
+			make(chan Result, 1),
+			make(chan Result, 1),
+		}
+
+		Convey("Add()", func() {
+			Convey("records the submission in redis", func() {
+				err := list.Add(ctx, hashes[0], listeners[0])
+				So(err, ShouldBeNil)
+
+				exists, err := redis.Bool(conn.Do("HEXISTS", RedisSubmissionListKey, hashes[0]))
+				So(err, ShouldBeNil)
+				So(exists, ShouldBeTrue)
+			})
+
+			Convey("errors when the provided hash is not 64-bytes", func() {
+				err := list.Add(ctx, "123", listeners[0])
+				So(err, ShouldNotBeNil)
+			})
+		})
+
+		Convey("Pending() includes submissions added to any list", func() {
+			list.Add(ctx, hashes[0], listeners[0])
+			other.Add(ctx, hashes[1], listeners[1])
+
+			So(list.Pending(ctx), ShouldContain, hashes[0])
+			So(list.Pending(ctx), ShouldContain, hashes[1])
+			So(len(other.Pending(ctx)), ShouldEqual, 2)
+		})
+
+		Convey("Finish()", func() {
+			list.Add(ctx, hashes[0], listeners[0])
+			r := Result{Hash: hashes[0]}
+
+			Convey("removes the submission from redis", func() {
+				err := other.Finish(ctx, r)
+				So(err, ShouldBeNil)
+				So(other.Pending(ctx), ShouldBeEmpty)
+				So(len(listeners[0]), ShouldEqual, 0)
+
+				Convey("leaving the listeners of the list they were added to open", func() {
+					So(list.Pending(ctx), ShouldResemble, []string{hashes[0]})
+
+					list.Finish(ctx, r)
+					So(<-listeners[0], ShouldResemble, r)
+					So(list.Pending(ctx), ShouldBeEmpty)
+				})
+			})
+		})
+
+		Convey("Clean()", func() {
+			old := strconv.FormatInt(time.Now().Add(-time.Minute).Unix(), 10)
+			_, err := conn.Do("HSET", RedisSubmissionListKey, hashes[0], old)
+			So(err, ShouldBeNil)
+			list.Add(ctx, hashes[1], listeners[1])
+
+			left, err := other.Clean(ctx, 30*time.Second)
+			So(err, ShouldBeNil)
+			So(left, ShouldEqual, 1)
+
+			Convey("removes submissions older than the maxAge provided", func() {
+				So(other.Pending(ctx), ShouldResemble, []string{hashes[1]})
+			})
+		})
+	})
+}
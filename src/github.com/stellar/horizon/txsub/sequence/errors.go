@@ -5,6 +5,7 @@ import (
 )
 
 var (
-	ErrNoMoreRoom  = errors.New("queue full")
-	ErrBadSequence = errors.New("bad sequence")
+	ErrNoMoreRoom       = errors.New("queue full")
+	ErrAccountQueueFull = errors.New("account queue full")
+	ErrBadSequence      = errors.New("bad sequence")
 )
@@ -6,22 +6,61 @@ import (
 	"sync"
 )
 
+// DefaultMaxSize is the default number of submissions a Manager buffers.
+const DefaultMaxSize = 1024
+
+// DefaultMaxAccountSize is the default number of submissions a Manager
+// buffers for a single address.  Zero leaves an address limited only by
+// MaxSize and the fair share of a manager that is half full.
+const DefaultMaxAccountSize = 0
+
+// The classes an address is placed in by its share of the limit in effect for
+// it: the lower of MaxAccountSize and its fair share of the manager.  See
+// Manager#SizeByClass.
+const (
+	// AccountClassLight contains addresses that use less than half of their
+	// limit.
+	AccountClassLight = "light"
+
+	// AccountClassHeavy contains addresses that use at least half of their
+	// limit without reaching it.
+	AccountClassHeavy = "heavy"
+
+	// AccountClassFull contains addresses that have reached their limit.
+	AccountClassFull = "full"
+)
+
+// AccountClasses lists every account class.
+var AccountClasses = []string{
+	AccountClassLight,
+	AccountClassHeavy,
+	AccountClassFull,
+}
+
 // Manager provides a system for tracking the transaction submission queue for
 // a set of addresses.  Requests to submit at a certain sequence number are
 // registered using the Push() method, and as the system is updated with
 // account sequence information (through the Update() method) requests are
 // notified that they can safely submit to stellar-core.
+//
+// The manager buffers at most MaxSize submissions, and, when set, at most
+// MaxAccountSize for any single address.  Once the manager is half full, room is shared
+// fairly: an address may not buffer more than an equal share of MaxSize
+// between it and the other addresses with buffered submissions, so that one
+// busy address cannot crowd out the rest.
 type Manager struct {
-	mutex   sync.Mutex
-	MaxSize int
-	queues  map[string]*Queue
+	mutex          sync.Mutex
+	MaxSize        int
+	MaxAccountSize int
+	queues         map[string]*Queue
 }
 
 // NewManager returns a new manager
 func NewManager() *Manager {
 	return &Manager{
-		MaxSize: 1024, //TODO: make MaxSize configurable
-		queues:  map[string]*Queue{},
+		MaxSize:        DefaultMaxSize,
+		MaxAccountSize: DefaultMaxAccountSize,
+		queues:         map[string]*Queue{},
 	}
 }
 
@@ -45,6 +84,25 @@ func (m *Manager) Size() int {
 	return m.size()
 }
 
+// SizeByClass returns the count of submissions buffered within this manager,
+// keyed by the class of the address they are buffered for.  Every class in
+// AccountClasses is present.
+func (m *Manager) SizeByClass() map[string]int {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	results := map[string]int{}
+	for _, class := range AccountClasses {
+		results[class] = 0
+	}
+
+	for address, q := range m.queues {
+		results[m.class(address, q.Size())] += q.Size()
+	}
+
+	return results
+}
+
 func (m *Manager) Addresses() []string {
 	m.mutex.Lock()
 	defer m.mutex.Unlock()
@@ -69,6 +127,16 @@ func (m *Manager) Push(address string, sequence uint64) <-chan error {
 	}
 
 	aq, ok := m.queues[address]
+
+	var accountSize int
+	if ok {
+		accountSize = aq.Size()
+	}
+
+	if accountSize >= m.accountLimit(address) {
+		return m.getError(ErrAccountQueueFull)
+	}
+
 	if !ok {
 		aq = NewQueue()
 		m.queues[address] = aq
@@ -107,6 +175,65 @@ func (m *Manager) size() int {
 	return result
 }
 
+// maxAccountSize returns the number of submissions that may be buffered for
+// a single address.  When MaxAccountSize is not set, an address may use the
+// whole of the manager.
+func (m *Manager) maxAccountSize() int {
+	if m.MaxAccountSize <= 0 || m.MaxAccountSize > m.MaxSize {
+		return m.MaxSize
+	}
+	return m.MaxAccountSize
+}
+
+// fairShare returns the number of submissions that may be buffered for
+// `address` given the current contention.  While the manager is less than
+// half full there is no limit beyond MaxAccountSize.  Past that, the room is
+// split equally between the addresses with buffered submissions, including
+// `address`.  This internal version assumes you have locked the manager
+// previously.
+func (m *Manager) fairShare(address string) int {
+	if m.size() < m.MaxSize/2 {
+		return m.MaxSize
+	}
+
+	accounts := len(m.queues)
+	if _, ok := m.queues[address]; !ok {
+		accounts++
+	}
+
+	share := m.MaxSize / accounts
+	if share < 1 {
+		share = 1
+	}
+	return share
+}
+
+// accountLimit returns the number of submissions that may be buffered for
+// `address` given the current contention.  This internal version assumes you
+// have locked the manager previously.
+func (m *Manager) accountLimit(address string) int {
+	limit := m.maxAccountSize()
+	if share := m.fairShare(address); share < limit {
+		return share
+	}
+	return limit
+}
+
+// class returns the class of `address`, with `size` buffered submissions.
+// This internal version assumes you have locked the manager previously.
+func (m *Manager) class(address string, size int) string {
+	max := m.accountLimit(address)
+
+	switch {
+	case size >= max:
+		return AccountClassFull
+	case size*2 >= max:
+		return AccountClassHeavy
+	default:
+		return AccountClassLight
+	}
+}
+
 func (m *Manager) getError(err error) <-chan error {
 	ch := make(chan error, 1)
 	ch <- err
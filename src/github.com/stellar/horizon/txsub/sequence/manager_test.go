@@ -1,8 +1,10 @@
 package sequence
 
 import (
-	. "github.com/smartystreets/goconvey/convey"
+	"strconv"
 	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
 )
 
 func TestManager(t *testing.T) {
@@ -43,11 +45,82 @@ func TestManager(t *testing.T) {
 
 		Convey("Push returns ErrNoMoreRoom when fill", func() {
 			for i := 0; i < mgr.MaxSize; i++ {
-				mgr.Push("1", 2)
+				mgr.Push(strconv.Itoa(i), 2)
 			}
 
 			So(mgr.Size(), ShouldEqual, 1024)
 			So(<-mgr.Push("1", 2), ShouldEqual, ErrNoMoreRoom)
+			So(<-mgr.Push("new", 2), ShouldEqual, ErrNoMoreRoom)
+		})
+
+		Convey("Push does not limit an address by default", func() {
+			// a full transaction batch from a single source account
+			for i := 0; i < 100; i++ {
+				So(len(mgr.Push("1", uint64(i+2))), ShouldEqual, 0)
+			}
+
+			So(mgr.Size(), ShouldEqual, 100)
+		})
+
+		Convey("Push returns ErrAccountQueueFull when an address is at its limit", func() {
+			mgr.MaxAccountSize = 64
+
+			for i := 0; i < mgr.MaxAccountSize; i++ {
+				So(len(mgr.Push("1", 2)), ShouldEqual, 0)
+			}
+
+			So(<-mgr.Push("1", 2), ShouldEqual, ErrAccountQueueFull)
+			So(len(mgr.Push("2", 2)), ShouldEqual, 0)
+		})
+
+		Convey("Push shares the room fairly once half full", func() {
+			mgr.MaxSize = 8
+			mgr.MaxAccountSize = 8
+
+			for i := 0; i < 4; i++ {
+				So(len(mgr.Push("1", 2)), ShouldEqual, 0)
+			}
+
+			// with two addresses, each may use half of the room
+			So(len(mgr.Push("2", 2)), ShouldEqual, 0)
+			So(<-mgr.Push("1", 2), ShouldEqual, ErrAccountQueueFull)
+
+			for i := 0; i < 3; i++ {
+				So(len(mgr.Push("2", 2)), ShouldEqual, 0)
+			}
+			So(<-mgr.Push("2", 2), ShouldEqual, ErrNoMoreRoom)
+		})
+
+		Convey("SizeByClass", func() {
+			mgr.MaxAccountSize = 4
+
+			mgr.Push("1", 2)
+			mgr.Push("2", 2)
+			mgr.Push("2", 3)
+			for i := 0; i < 4; i++ {
+				mgr.Push("3", 2)
+			}
+
+			So(mgr.SizeByClass(), ShouldResemble, map[string]int{
+				AccountClassLight: 1,
+				AccountClassHeavy: 2,
+				AccountClassFull:  4,
+			})
+		})
+
+		Convey("SizeByClass uses the fair share once half full", func() {
+			mgr.MaxSize = 8
+
+			for i := 0; i < 4; i++ {
+				mgr.Push("1", 2)
+			}
+			mgr.Push("2", 2)
+
+			So(mgr.SizeByClass(), ShouldResemble, map[string]int{
+				AccountClassLight: 1,
+				AccountClassHeavy: 0,
+				AccountClassFull:  4,
+			})
 		})
 	})
 }
@@ -16,10 +16,16 @@ import (
 type System struct {
 	initializer sync.Once
 
+	// queued counts the submissions of each transaction hash that are waiting
+	// in the SubmissionQueue or being submitted to stellar-core.
+	queuedLock sync.Mutex
+	queued     map[string]int
+
 	Pending           OpenSubmissionList
 	Results           ResultProvider
 	Sequences         SequenceProvider
 	Submitter         Submitter
+	Validator         Validator
 	SubmissionQueue   *sequence.Manager
 	NetworkPassphrase string
 	SubmissionTimeout time.Duration
@@ -33,6 +39,11 @@ type System struct {
 		// behind this system's SubmissionQueue
 		BufferedSubmissionsGauge metrics.Gauge
 
+		// BufferedSubmissionsByClass tracks the count of submissions buffered
+		// behind this system's SubmissionQueue for each class of source
+		// account, keyed by the classes in sequence.AccountClasses.
+		BufferedSubmissionsByClass map[string]metrics.Gauge
+
 		// OpenSubmissionsGauge tracks the count of "open" submissions (i.e.
 		// submissions whose transactions haven't been confirmed successful or failed
 		OpenSubmissionsGauge metrics.Gauge
@@ -44,9 +55,24 @@ type System struct {
 		// SuccessfulSubmissionsMeter tracks the rate of successful transactions that
 		// have been submitted to this process
 		SuccessfulSubmissionsMeter metrics.Meter
+
+		// Targets tracks the submissions made to each of the stellar-core
+		// instances of a TargetedSubmitter, keyed by url.
+		Targets map[string]*TargetMetrics
 	}
 }
 
+// TargetMetrics tracks the submissions made to a single stellar-core instance.
+type TargetMetrics struct {
+	// SubmissionTimer exposes timing metrics about the rate and latency of
+	// submissions to the instance
+	SubmissionTimer metrics.Timer
+
+	// ErrorsMeter tracks the rate of submissions to the instance that
+	// errored for reasons other than the transaction failing
+	ErrorsMeter metrics.Meter
+}
+
 // Submit submits the provided base64 encoded transaction envelope to the
 // network using this submission system.
 func (sys *System) Submit(ctx context.Context, env string) (result <-chan Result) {
@@ -82,6 +108,19 @@ func (sys *System) Submit(ctx context.Context, env string) (result <-chan Result
 		return
 	}
 
+	// check the transaction before it is submitted, so that transactions
+	// stellar-core would reject fail early
+	if sys.Validator != nil {
+		err = sys.Validator.Validate(ctx, env)
+		if err != nil {
+			sys.finish(response, Result{Err: err, EnvelopeXDR: env})
+			return
+		}
+	}
+
+	sys.markQueued(info.Hash, 1)
+	defer sys.markQueued(info.Hash, -1)
+
 	// queue the submission and get the channel that will emit when
 	// submission is valid
 	seq := sys.SubmissionQueue.Push(info.SourceAddress, info.Sequence)
@@ -143,6 +182,83 @@ func (sys *System) Submit(ctx context.Context, env string) (result <-chan Result
 	return
 }
 
+// Validate checks the provided base64 encoded transaction envelope using the
+// system's Validator, without submitting it, returning the transaction's hash.
+func (sys *System) Validate(ctx context.Context, env string) (string, error) {
+	info, err := extractEnvelopeInfo(ctx, env, sys.NetworkPassphrase)
+	if err != nil {
+		return "", err
+	}
+
+	if sys.Validator == nil {
+		return info.Hash, nil
+	}
+
+	return info.Hash, sys.Validator.Validate(ctx, env)
+}
+
+// SubmitAsync submits the provided base64 encoded transaction envelope to the
+// network in the same way as Submit, but does not wait for the transaction to
+// be included in a ledger.  When stellar-core accepts the transaction,
+// `accepted` is true and the result carries only the transaction's hash and
+// envelope.  Otherwise, the result is the submission's final result.
+func (sys *System) SubmitAsync(ctx context.Context, env string) (result Result, accepted bool) {
+	response := sys.Submit(ctx, env)
+
+	select {
+	case result = <-response:
+		return
+	default:
+	}
+
+	// Submit has already validated the envelope
+	info, err := extractEnvelopeInfo(ctx, env, sys.NetworkPassphrase)
+	if err != nil {
+		result = Result{Err: err, EnvelopeXDR: env}
+		return
+	}
+
+	result = Result{Hash: info.Hash, EnvelopeXDR: env}
+	accepted = true
+	return
+}
+
+// Status reports how far the submission of the transaction identified by
+// `hash` has progressed.  For transactions that have been included in a ledger
+// the transaction's result is returned as well, with a
+// *FailedTransactionError as its Err when the transaction failed.
+func (sys *System) Status(ctx context.Context, hash string) (TransactionStatus, Result, error) {
+	sys.Init()
+
+	r := sys.Results.ResultByHash(ctx, hash)
+	switch r.Err.(type) {
+	case nil:
+		return TransactionStatusSuccess, r, nil
+	case *FailedTransactionError:
+		return TransactionStatusFailed, r, nil
+	}
+
+	if r.Err != ErrNoResults {
+		return TransactionStatusUnknown, r, r.Err
+	}
+
+	for _, pending := range sys.Pending.Pending(ctx) {
+		if pending == hash {
+			return TransactionStatusPending, r, nil
+		}
+	}
+
+	sys.queuedLock.Lock()
+	queued := sys.queued[hash] > 0
+	sys.queuedLock.Unlock()
+
+	if queued {
+		return TransactionStatusQueued, r, nil
+	}
+
+	return TransactionStatusUnknown, r, nil
+}
+
 // Submit submits the provided base64 encoded transaction envelope to the
 // network using this submission system.
 func (sys *System) submitOnce(ctx context.Context, env string) SubmissionResult {
@@ -150,6 +266,18 @@ func (sys *System) submitOnce(ctx context.Context, env string) SubmissionResult
 	sr := sys.Submitter.Submit(ctx, env)
 	sys.Metrics.SubmissionTimer.Update(sr.Duration)
 
+	for _, attempt := range sr.Attempts {
+		target, ok := sys.Metrics.Targets[attempt.Target]
+		if !ok {
+			continue
+		}
+
+		target.SubmissionTimer.Update(attempt.Duration)
+		if _, failed := attempt.Err.(*FailedTransactionError); attempt.Err != nil && !failed {
+			target.ErrorsMeter.Mark(1)
+		}
+	}
+
 	// if received or duplicate, add to the open submissions list
 	if sr.Err == nil {
 		sys.Metrics.SuccessfulSubmissionsMeter.Mark(1)
@@ -169,6 +297,12 @@ func (sys *System) Tick(ctx context.Context) {
 		WithField("queued", sys.SubmissionQueue.String()).
 		Debug("ticking txsub system")
 
+	// health checks run in the background so that a stellar-core that is slow
+	// to respond does not hold up the tick.
+	if hc, ok := sys.Submitter.(HealthChecker); ok {
+		go hc.CheckHealth(ctx)
+	}
+
 	addys := sys.SubmissionQueue.Addresses()
 	if len(addys) > 0 {
 		curSeq, err := sys.Sequences.Get(addys)
@@ -208,6 +342,12 @@ func (sys *System) Tick(ctx context.Context) {
 
 	sys.Metrics.OpenSubmissionsGauge.Update(int64(stillOpen))
 	sys.Metrics.BufferedSubmissionsGauge.Update(int64(sys.SubmissionQueue.Size()))
+
+	for class, size := range sys.SubmissionQueue.SizeByClass() {
+		if gauge, ok := sys.Metrics.BufferedSubmissionsByClass[class]; ok {
+			gauge.Update(int64(size))
+		}
+	}
 }
 
 // Init initializes `sys`
@@ -218,13 +358,42 @@ func (sys *System) Init() {
 		sys.Metrics.SubmissionTimer = metrics.NewTimer()
 		sys.Metrics.OpenSubmissionsGauge = metrics.NewGauge()
 		sys.Metrics.BufferedSubmissionsGauge = metrics.NewGauge()
+		sys.Metrics.Targets = map[string]*TargetMetrics{}
+
+		sys.Metrics.BufferedSubmissionsByClass = map[string]metrics.Gauge{}
+		for _, class := range sequence.AccountClasses {
+			sys.Metrics.BufferedSubmissionsByClass[class] = metrics.NewGauge()
+		}
+
+		if ts, ok := sys.Submitter.(TargetedSubmitter); ok {
+			for _, target := range ts.Targets() {
+				sys.Metrics.Targets[target] = &TargetMetrics{
+					SubmissionTimer: metrics.NewTimer(),
+					ErrorsMeter:     metrics.NewMeter(),
+				}
+			}
+		}
 
 		if sys.SubmissionTimeout == 0 {
 			sys.SubmissionTimeout = 1 * time.Minute
 		}
+
+		sys.queued = map[string]int{}
 	})
 }
 
+// markQueued adjusts the count of queued submissions of the transaction
+// identified by `hash` by `delta`.
+func (sys *System) markQueued(hash string, delta int) {
+	sys.queuedLock.Lock()
+	defer sys.queuedLock.Unlock()
+
+	sys.queued[hash] += delta
+	if sys.queued[hash] <= 0 {
+		delete(sys.queued, hash)
+	}
+}
+
 func (sys *System) finish(response chan<- Result, r Result) {
 	response <- r
 	close(response)
@@ -7,6 +7,7 @@ import (
 
 	. "github.com/smartystreets/goconvey/convey"
 	"github.com/stellar/go/build"
+	"github.com/stellar/go/xdr"
 	"github.com/stellar/horizon/test"
 	"github.com/stellar/horizon/txsub/sequence"
 )
@@ -83,6 +84,17 @@ func TestTxsub(t *testing.T) {
 				So(submitter.WasSubmittedTo, ShouldBeTrue)
 			})
 
+			Convey("returns the error from the validator without submitting", func() {
+				invalid := &InvalidTransactionError{Code: xdr.TransactionResultCodeTxBadAuth, Reason: "unsigned"}
+				validator := &MockValidator{Err: invalid}
+				system.Validator = validator
+				r := <-system.Submit(ctx, successTx.EnvelopeXDR)
+
+				So(r.Err, ShouldEqual, invalid)
+				So(validator.WasValidated, ShouldBeTrue)
+				So(submitter.WasSubmittedTo, ShouldBeFalse)
+			})
+
 			Convey("if no result found and no error submitting, add to open transaction list", func() {
 				_ = system.Submit(ctx, successTx.EnvelopeXDR)
 				pending := system.Pending.Pending(ctx)
@@ -94,6 +106,142 @@ func TestTxsub(t *testing.T) {
 			})
 		})
 
+		Convey("records metrics for each stellar-core of a TargetedSubmitter", func() {
+			targeted := &mockTargetedSubmitter{
+				targets: []string{"http://core-1", "http://core-2"},
+				MockSubmitter: MockSubmitter{
+					R: SubmissionResult{
+						Attempts: []SubmissionAttempt{
+							{Target: "http://core-1", Err: errors.New("connection refused"), Duration: time.Millisecond},
+							{Target: "http://core-2", Duration: time.Millisecond},
+						},
+					},
+				},
+			}
+			system.Submitter = targeted
+
+			_ = system.Submit(ctx, successTx.EnvelopeXDR)
+
+			So(len(system.Metrics.Targets), ShouldEqual, 2)
+			So(system.Metrics.Targets["http://core-1"].SubmissionTimer.Count(), ShouldEqual, 1)
+			So(system.Metrics.Targets["http://core-1"].ErrorsMeter.Count(), ShouldEqual, 1)
+			So(system.Metrics.Targets["http://core-2"].SubmissionTimer.Count(), ShouldEqual, 1)
+			So(system.Metrics.Targets["http://core-2"].ErrorsMeter.Count(), ShouldEqual, 0)
+			So(system.Metrics.SuccessfulSubmissionsMeter.Count(), ShouldEqual, 1)
+		})
+
+		Convey("Validate", func() {
+			Convey("returns the transaction's hash when it is valid", func() {
+				system.Validator = &MockValidator{}
+				hash, err := system.Validate(ctx, successTx.EnvelopeXDR)
+
+				So(err, ShouldBeNil)
+				So(hash, ShouldEqual, successTx.Hash)
+			})
+
+			Convey("returns the error from the validator", func() {
+				system.Validator = &MockValidator{Err: &InvalidTransactionError{}}
+				_, err := system.Validate(ctx, successTx.EnvelopeXDR)
+
+				So(err, ShouldHaveSameTypeAs, &InvalidTransactionError{})
+			})
+
+			Convey("errors when the envelope is malformed", func() {
+				_, err := system.Validate(ctx, "not an envelope")
+
+				So(err, ShouldHaveSameTypeAs, &MalformedTransactionError{})
+			})
+		})
+
+		Convey("SubmitAsync", func() {
+			Convey("returns the hash once stellar-core accepts the transaction", func() {
+				r, accepted := system.SubmitAsync(ctx, successTx.EnvelopeXDR)
+
+				So(accepted, ShouldBeTrue)
+				So(r.Err, ShouldBeNil)
+				So(r.Hash, ShouldEqual, successTx.Hash)
+				So(r.EnvelopeXDR, ShouldEqual, successTx.EnvelopeXDR)
+				So(submitter.WasSubmittedTo, ShouldBeTrue)
+				So(system.Pending.Pending(ctx), ShouldResemble, []string{successTx.Hash})
+			})
+
+			Convey("returns the result when one is already available", func() {
+				results.Results = []Result{successTx}
+				r, accepted := system.SubmitAsync(ctx, successTx.EnvelopeXDR)
+
+				So(accepted, ShouldBeFalse)
+				So(r.Err, ShouldBeNil)
+				So(r.LedgerSequence, ShouldEqual, successTx.LedgerSequence)
+				So(submitter.WasSubmittedTo, ShouldBeFalse)
+			})
+
+			Convey("returns the error when the submission fails", func() {
+				submitter.R.Err = errors.New("busted for some reason")
+				r, accepted := system.SubmitAsync(ctx, successTx.EnvelopeXDR)
+
+				So(accepted, ShouldBeFalse)
+				So(r.Err, ShouldNotBeNil)
+			})
+		})
+
+		Convey("Status", func() {
+			Convey("reports success for transactions with a result", func() {
+				results.Results = []Result{successTx}
+				status, r, err := system.Status(ctx, successTx.Hash)
+
+				So(err, ShouldBeNil)
+				So(status, ShouldEqual, TransactionStatusSuccess)
+				So(r.LedgerSequence, ShouldEqual, successTx.LedgerSequence)
+			})
+
+			Convey("reports failure for transactions with a failed result", func() {
+				failed := successTx
+				failed.Err = &FailedTransactionError{ResultXDR: successTx.ResultXDR}
+				results.Results = []Result{failed}
+				status, _, err := system.Status(ctx, successTx.Hash)
+
+				So(err, ShouldBeNil)
+				So(status, ShouldEqual, TransactionStatusFailed)
+			})
+
+			Convey("returns errors from the result provider", func() {
+				results.Results = []Result{{Err: errors.New("db down")}}
+				_, _, err := system.Status(ctx, successTx.Hash)
+
+				So(err, ShouldNotBeNil)
+			})
+
+			Convey("reports pending for transactions in the open submission list", func() {
+				system.Pending.Add(ctx, successTx.Hash, make(chan Result, 1))
+				status, _, err := system.Status(ctx, successTx.Hash)
+
+				So(err, ShouldBeNil)
+				So(status, ShouldEqual, TransactionStatusPending)
+			})
+
+			Convey("reports queued for transactions waiting in the submission queue", func() {
+				system.Init()
+				system.markQueued(successTx.Hash, 1)
+				status, _, err := system.Status(ctx, successTx.Hash)
+
+				So(err, ShouldBeNil)
+				So(status, ShouldEqual, TransactionStatusQueued)
+
+				system.markQueued(successTx.Hash, -1)
+				status, _, err = system.Status(ctx, successTx.Hash)
+
+				So(err, ShouldBeNil)
+				So(status, ShouldEqual, TransactionStatusUnknown)
+			})
+
+			Convey("reports unknown for other transactions", func() {
+				status, _, err := system.Status(ctx, successTx.Hash)
+
+				So(err, ShouldBeNil)
+				So(status, ShouldEqual, TransactionStatusUnknown)
+			})
+		})
+
 		Convey("Tick", func() {
 
 			Convey("no-ops if there are no open submissions", func() {
@@ -136,3 +284,12 @@ func TestTxsub(t *testing.T) {
 
 	})
 }
+
+type mockTargetedSubmitter struct {
+	MockSubmitter
+	targets []string
+}
+
+func (sub *mockTargetedSubmitter) Targets() []string {
+	return sub.targets
+}
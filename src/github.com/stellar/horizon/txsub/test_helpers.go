@@ -51,3 +51,15 @@ type MockSequenceProvider struct {
 func (results *MockSequenceProvider) Get(addresses []string) (map[string]uint64, error) {
 	return results.Results, results.Err
 }
+
+// MockValidator is a test helper that simplements the Validator interface
+type MockValidator struct {
+	Err          error
+	WasValidated bool
+}
+
+// Validate implements `txsub.Validator`
+func (v *MockValidator) Validate(ctx context.Context, env string) error {
+	v.WasValidated = true
+	return v.Err
+}